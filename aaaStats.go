@@ -0,0 +1,76 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawAAAStats is the payload as returned by the Nitro API.
+type RawAAAStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawAAAStats) Len() int {
+	return len(r)
+}
+
+// AAAStats represents the data returned from the /stat/aaa Nitro API endpoint
+type AAAStats struct {
+	AuthSuccess           string `json:"aaaauthsuccess"`
+	AuthFail              string `json:"aaaauthfail"`
+	CurrentSessions       string `json:"aaacursessions"`
+	TotalSessions         string `json:"aaatotsessions"`
+	TotalSessionTimeouts  string `json:"aaatotsessiontimeout"`
+	CurrentICASessions    string `json:"aaacuricasessions"`
+	CurrentICAConnections string `json:"aaacuricaconn"`
+	CurrentTMSessions     string `json:"aaacurtmsessions"`
+	TotalTMSessions       string `json:"aaatottmsessions"`
+}
+
+// NitroType implements the NitroData interface.
+func (s AAAStats) NitroType() string {
+	return aaaSubsystem
+}
+
+func processAAAStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := aaaSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`aaa`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawAAAStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/aaa/aaa/
+
+const aaaSubsystem = `aaa`
+
+var (
+	aaaLabels      = []string{netscalerInstance}
+	aaaAuthSuccess = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "auth_success_total",
+			Help:      "Total number of successful authentications",
+		},
+		aaaLabels,
+	)
+
+	aaaAuthFail = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "auth_failures_total",
+			Help:      "Total number of failed authentications",
+		},
+		aaaLabels,
+	)
+
+	aaaCurSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_sessions",
+			Help:      "Number of current AAA sessions",
+		},
+		aaaLabels,
+	)
+
+	aaaTotalSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "sessions_total",
+			Help:      "Total number of AAA sessions created",
+		},
+		aaaLabels,
+	)
+
+	aaaSessionTimeouts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "session_timeouts_total",
+			Help:      "Total number of AAA sessions that have timed out",
+		},
+		aaaLabels,
+	)
+
+	aaaCurICASessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_ica_sessions",
+			Help:      "Number of current ICA sessions",
+		},
+		aaaLabels,
+	)
+
+	aaaCurICAConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_ica_connections",
+			Help:      "Number of current ICA connections",
+		},
+		aaaLabels,
+	)
+
+	aaaCurTMSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_tm_sessions",
+			Help:      "Number of current traffic management AAA sessions",
+		},
+		aaaLabels,
+	)
+
+	aaaTotalTMSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "tm_sessions_total",
+			Help:      "Total number of traffic management AAA sessions created",
+		},
+		aaaLabels,
+	)
+)
+
+func (P *Pool) promAAAStats(ss AAAStats) {
+	aaaAuthSuccess.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.AuthSuccess))
+	aaaAuthFail.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.AuthFail))
+	aaaCurSessions.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.CurrentSessions))
+	aaaTotalSessions.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSessions))
+	aaaSessionTimeouts.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSessionTimeouts))
+	aaaCurICASessions.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.CurrentICASessions))
+	aaaCurICAConns.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.CurrentICAConnections))
+	aaaCurTMSessions.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.CurrentTMSessions))
+	aaaTotalTMSessions.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalTMSessions))
+	P.labelTTLs.setTTL(aaaCollection, P.nsInstance)
+}
+
+var aaaCollection = gaugeCollection{
+	aaaAuthSuccess,
+	aaaAuthFail,
+	aaaCurSessions,
+	aaaTotalSessions,
+	aaaSessionTimeouts,
+	aaaCurICASessions,
+	aaaCurICAConns,
+	aaaCurTMSessions,
+	aaaTotalTMSessions,
+}
@@ -102,6 +102,18 @@ func makeCounterRegistry() *prometheus.Registry {
 }
 
 var counterPromCollectors = []prometheus.Collector{
+	csvserverTotalHits,
+	csvserverTotalRequests,
+	csvserverTotalResponses,
+	csvserverTotalRequestBytes,
+	csvserverTotalResponseBytes,
+	csvserverTotalPktsRx,
+	csvserverTotalPktsTx,
+	csvserverPolicyTotalHits,
+	servicegroupMemberTotalRequests,
+	servicegroupMemberTotalResponses,
+	servicegroupMemberTotalRequestBytes,
+	servicegroupMemberTotalResponseBytes,
 	gslbServicesHits,
 	gslbServicesTotalRequestBytes,
 	gslbServicesTotalResponseBytes,
@@ -121,10 +133,116 @@ var counterPromCollectors = []prometheus.Collector{
 	lbvsvrServiceTotalResponseBytes,
 	sslTotalTransactions,
 	sslTotalSessions,
+	sslVServerTotalHandshakes,
+	sslVServerTotalRenegotiations,
+	sslVServerTotalSessionHits,
+	sslVServerTotalSessionMisses,
+	sslVServerTotalTransactions,
 	nsTotalRxBytes,
 	nsTotalTxBytes,
 	nsHTTPReqsTotal,
 	nsHTTPRespTotal,
+	interfaceTotalRxBytes,
+	interfaceTotalTxBytes,
+	interfaceTotalRxPkts,
+	interfaceTotalTxPkts,
+	interfaceDroppedRxPkts,
+	interfaceDroppedTxPkts,
+	interfaceErrorRxPkts,
+	interfaceErrorTxPkts,
+	haTotalHeartbeatsRx,
+	haTotalHeartbeatsTx,
+	haTotalPropagationTimeouts,
+	haTotalSyncFailures,
+	clusterNodeBackplaneRxBytes,
+	clusterNodeBackplaneTxBytes,
+	protocolTCPRxPkts,
+	protocolTCPRxBytes,
+	protocolTCPTxPkts,
+	protocolTCPTxBytes,
+	protocolTCPSyn,
+	protocolTCPSynHeld,
+	protocolTCPSynProbes,
+	protocolTCPSynFlushed,
+	protocolTCPSynDropped,
+	protocolTCPErrors,
+	protocolHTTPRequests,
+	protocolHTTPResponses,
+	protocolHTTPRxRequestBytes,
+	protocolHTTPRxResponseBytes,
+	protocolHTTPGets,
+	protocolHTTPPosts,
+	protocolHTTPOthers,
+	protocolHTTPErrors,
+	protocolHTTPResponseClasses,
+	protocolIPRxPkts,
+	protocolIPRxBytes,
+	protocolIPTxPkts,
+	protocolIPTxBytes,
+	protocolIPFragments,
+	protocolIPReassemblyAttempts,
+	protocolIPSuccessReassembly,
+	protocolIPErrors,
+	memoryPoolAllocFailures,
+	aaaAuthSuccess,
+	aaaAuthFail,
+	aaaTotalSessions,
+	aaaSessionTimeouts,
+	aaaTotalTMSessions,
+	vpnVServerTotalRequests,
+	vpnVServerTotalResponses,
+	vpnVServerTotalRequestBytes,
+	vpnVServerTotalResponseBytes,
+	cacheHits,
+	cacheMisses,
+	cacheRequests,
+	cmpRequests,
+	cmpRxBytes,
+	cmpTxBytes,
+	cmpBytesSaved,
+	appfwRequests,
+	appfwResponses,
+	appfwAborted,
+	appfwRedirects,
+	appfwViolationsTotal,
+	appfwViolations,
+	appfwProfileRequests,
+	appfwProfileResponses,
+	appfwProfileAborted,
+	appfwProfileRedirects,
+	appfwProfileViolationsTotal,
+	appfwProfileViolations,
+	policyHits,
+	policyUndefHits,
+	dnsQueries,
+	dnsAnswers,
+	dnsNXDomain,
+	dnsCacheHits,
+	dnsRecordQueries,
+	dnsRecordResponses,
+	gslbDomainQueries,
+	gslbSiteTotalRequests,
+	gslbSiteTotalResponses,
+	gslbSiteMEPReceivedBytes,
+	gslbSiteMEPSentBytes,
+	monitorProbes,
+	monitorFailedProbes,
+	vlanRxPkts,
+	vlanRxBytes,
+	vlanTxPkts,
+	vlanTxBytes,
+	vlanDroppedPkts,
+	vlanBroadcastPkts,
+	bridgeMACMoved,
+	bridgeLoops,
+	bridgeCollisions,
+	bridgeInterfaceMismatch,
+	lsnPortAllocFailures,
+	lsnDroppedPkts,
+	lsnGroupPortAllocFailures,
+	nslimitHits,
+	nslimitDrops,
+	nslimitSessionHits,
 }
 
 var allPromCollectors = []prometheus.Collector{
@@ -133,13 +251,35 @@ var allPromCollectors = []prometheus.Collector{
 	exporterMissedMetrics,
 	exporterPromCollectFailures,
 	exporterPromProcessingTime,
+	clusterInstanceStatus,
+	clusterNodeHealth,
+	clusterNodeEffectiveHealth,
+	clusterNodeState,
+	clusterNodeSyncInfo,
+	csvserverState,
+	csvserverEstablishedConns,
+	csvserverCurrentClientConns,
+	csvserverCurrentServerConns,
+	csvserverTargetInfo,
 	gslbServicesEstablishedConns,
 	gslbServicesState,
 	gslbVServerActiveServices,
 	gslbVServerEstablishedConns,
 	gslbVServerHealth,
 	gslbVServerState,
+	haCurState,
+	haCurMasterState,
+	haRoleTransitions,
+	haNodeRole,
+	haNodeSyncEnabled,
+	haNodeFlips,
+	interfaceState,
+	interfaceLinkState,
+	interfaceLinkUptime,
+	interfaceSpeed,
 	lbvserverLastStateChangeSecs,
+	lbvserverEffectiveState,
+	lbvserverConfigInfo,
 	lbvserverAveCLTTLB,
 	lbvserverState,
 	lbvserverTotalClientTTLBTrans,
@@ -160,6 +300,7 @@ var allPromCollectors = []prometheus.Collector{
 	lbvsvrServiceVirtualServerServiceHits,
 	lbvsvrServiceActiveTransactions,
 	nsCPUUsagePct,
+	nsCPUCoreUsagePct,
 	nsMgmtCPUUsagePct,
 	nsMemUsagePct,
 	nsPktCPUUsagePct,
@@ -169,5 +310,58 @@ var allPromCollectors = []prometheus.Collector{
 	nsTCPCurClientConnsEst,
 	nsTCPCurServerConns,
 	nsTCPCurServerConnsEst,
+	servicegroupState,
+	servicegroupBindingInfo,
+	servicegroupMemberState,
+	servicegroupMemberAvgTTFB,
+	servicegroupMemberCurrentClientConns,
+	servicegroupMemberCurrentServerConns,
+	servicegroupMemberServerEstablishedConnections,
+	servicegroupMemberSurgeCount,
 	sslCurrentSessions,
+	sslVServerState,
+	sslVServerSessionReusePct,
+	sslCertKeyDaysToExpire,
+	sslCertKeyStatus,
+	sslCertKeyBindingInfo,
+	memoryUsedBytes,
+	memoryUsagePct,
+	memoryPoolAllocBytes,
+	memoryPoolAllocPct,
+	memoryPoolTotalBytes,
+	memoryPoolFreeBytes,
+	aaaCurSessions,
+	aaaCurICASessions,
+	aaaCurICAConns,
+	aaaCurTMSessions,
+	vpnVServerState,
+	vpnVServerCurUsers,
+	cacheHitPct,
+	cacheStoredObjects,
+	cacheUsedMemBytes,
+	cacheMaxMemBytes,
+	cmpRatio,
+	cmpBandwidthSavingPct,
+	gslbSiteMEPState,
+	gslbSitePersistenceMEPState,
+	gslbSiteMetricExchange,
+	gslbSiteSessionExchange,
+	serverState,
+	monitorState,
+	monitorCurFailedProbes,
+	monitorResponseTime,
+	capacityLicensedBandwidth,
+	capacityThroughput,
+	capacityUsagePct,
+	capacityFeatureLicensed,
+	lsnCurSessions,
+	lsnGroupPortUsagePct,
+	lsnGroupCurSessions,
+	nslimitCurSessions,
+	lbvserverPersistentSessions,
+	nsBootTime,
+	nsLastConfigChangeTime,
+	nsLastConfigSaveTime,
+	nsConfigUnsaved,
+	policyBindingInfo,
 }
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawAppFWStats is the payload as returned by the Nitro API.
+type RawAppFWStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawAppFWStats) Len() int {
+	return len(r)
+}
+
+// RawAppFWProfileStats is the payload as returned by the Nitro API.
+type RawAppFWProfileStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawAppFWProfileStats) Len() int {
+	return len(r)
+}
+
+// AppFWCounters are the request and violation counters common to the /stat/appfw and /stat/appfwprofile Nitro API endpoints.
+type AppFWCounters struct {
+	TotalRequests        string `json:"appfirewallrequests"`
+	TotalResponses       string `json:"appfirewallresponses"`
+	TotalAborts          string `json:"appfirewallaborts"`
+	TotalRedirects       string `json:"appfirewallredirects"`
+	TotalViolations      string `json:"appfirewalltotalviol"`
+	ViolStartURL         string `json:"appfirewallviolstarturl"`
+	ViolDenyURL          string `json:"appfirewallvioldenyurl"`
+	ViolRefererHeader    string `json:"appfirewallviolrefererheader"`
+	ViolBufferOverflow   string `json:"appfirewallviolbufferoverflow"`
+	ViolCookie           string `json:"appfirewallviolcookie"`
+	ViolCSRFTag          string `json:"appfirewallviolcsrftag"`
+	ViolXSS              string `json:"appfirewallviolxss"`
+	ViolSQL              string `json:"appfirewallviolsql"`
+	ViolFieldFormat      string `json:"appfirewallviolfieldformat"`
+	ViolFieldConsistency string `json:"appfirewallviolfieldconsistency"`
+	ViolCreditCard       string `json:"appfirewallviolcreditcard"`
+	ViolSafeObject       string `json:"appfirewallviolsafeobject"`
+	ViolSignature        string `json:"appfirewallviolsignature"`
+}
+
+// violations returns the violation counters keyed by violation type.
+func (c AppFWCounters) violations() map[string]string {
+	return map[string]string{
+		`start_url`:         c.ViolStartURL,
+		`deny_url`:          c.ViolDenyURL,
+		`referer_header`:    c.ViolRefererHeader,
+		`buffer_overflow`:   c.ViolBufferOverflow,
+		`cookie`:            c.ViolCookie,
+		`csrf_tag`:          c.ViolCSRFTag,
+		`xss`:               c.ViolXSS,
+		`sql_injection`:     c.ViolSQL,
+		`field_format`:      c.ViolFieldFormat,
+		`field_consistency`: c.ViolFieldConsistency,
+		`credit_card`:       c.ViolCreditCard,
+		`safe_object`:       c.ViolSafeObject,
+		`signature`:         c.ViolSignature,
+	}
+}
+
+// AppFWStats represents the data returned from the /stat/appfw Nitro API endpoint
+type AppFWStats struct {
+	AppFWCounters
+}
+
+// AppFWProfileStats represents the data returned from the /stat/appfwprofile Nitro API endpoint
+type AppFWProfileStats struct {
+	Name string `json:"name"`
+	AppFWCounters
+}
+
+// NitroType implements the NitroData interface.
+func (s AppFWStats) NitroType() string {
+	return appfwSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s AppFWProfileStats) NitroType() string {
+	return appfwSubsystem
+}
+
+func processAppFWStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := appfwSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`appfw`))
+			profData := submitAPITask(P, P.nitroStat(`appfwprofile`))
+			switch {
+			case len(data) < 1 || len(profData) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				noErr := true
+				for _, raw := range []NitroRaw{RawAppFWStats(data), RawAppFWProfileStats(profData)} {
+					req := newNitroRawReq(raw)
+					P.submit(req)
+					s := <-req.ResultChan()
+					if success, ok := s.(bool); !ok || !success {
+						noErr = false
+					}
+				}
+				switch {
+				case noErr:
+					go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+					timeEnd := time.Now().UnixNano()
+					exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				default:
+					exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/appfw/appfw/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/appfw/appfwprofile/
+
+const appfwSubsystem = `appfw`
+
+var (
+	appfwLabels   = []string{netscalerInstance}
+	appfwRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests inspected by the application firewall",
+		},
+		appfwLabels,
+	)
+
+	appfwResponses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of responses inspected by the application firewall",
+		},
+		appfwLabels,
+	)
+
+	appfwAborted = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "aborted_requests_total",
+			Help:      "Total number of requests aborted by the application firewall",
+		},
+		appfwLabels,
+	)
+
+	appfwRedirects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "redirects_total",
+			Help:      "Total number of requests redirected by the application firewall",
+		},
+		appfwLabels,
+	)
+
+	appfwViolationsTotal = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "violations_total",
+			Help:      "Total number of security check violations",
+		},
+		appfwLabels,
+	)
+)
+
+var (
+	appfwViolationLabels = []string{netscalerInstance, `citrixadc_appfw_violation`}
+	appfwViolations      = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "violations_by_type_total",
+			Help:      "Total number of security check violations by violation type",
+		},
+		appfwViolationLabels,
+	)
+)
+
+var (
+	appfwProfileLabels   = []string{netscalerInstance, `citrixadc_appfw_profile`}
+	appfwProfileRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_requests_total",
+			Help:      "Total number of requests inspected by this application firewall profile",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileResponses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_responses_total",
+			Help:      "Total number of responses inspected by this application firewall profile",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileAborted = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_aborted_requests_total",
+			Help:      "Total number of requests aborted by this application firewall profile",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileRedirects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_redirects_total",
+			Help:      "Total number of requests redirected by this application firewall profile",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileViolationsTotal = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_violations_total",
+			Help:      "Total number of security check violations",
+		},
+		appfwProfileLabels,
+	)
+)
+
+var (
+	appfwProfileViolationLabels = []string{netscalerInstance, `citrixadc_appfw_profile`, `citrixadc_appfw_violation`}
+	appfwProfileViolations      = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_violations_by_type_total",
+			Help:      "Total number of security check violations for this application firewall profile by violation type",
+		},
+		appfwProfileViolationLabels,
+	)
+)
+
+func (P *Pool) promAppFWStats(ss AppFWStats) {
+	appfwRequests.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRequests))
+	appfwResponses.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalResponses))
+	appfwAborted.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalAborts))
+	appfwRedirects.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRedirects))
+	appfwViolationsTotal.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalViolations))
+	P.labelTTLs.setTTL(appfwCollection, P.nsInstance)
+
+	for violation, val := range ss.violations() {
+		appfwViolations.WithLabelValues(P.nsInstance, violation).Set(cast.ToFloat64(val))
+		P.labelTTLs.setTTL(appfwViolationCollection, P.nsInstance, violation)
+	}
+}
+
+func (P *Pool) promAppFWProfileStats(ss AppFWProfileStats) {
+	appfwProfileRequests.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalRequests))
+	appfwProfileResponses.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalResponses))
+	appfwProfileAborted.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalAborts))
+	appfwProfileRedirects.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalRedirects))
+	appfwProfileViolationsTotal.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalViolations))
+	P.labelTTLs.setTTL(appfwProfileCollection, P.nsInstance, ss.Name)
+
+	for violation, val := range ss.violations() {
+		appfwProfileViolations.WithLabelValues(P.nsInstance, ss.Name, violation).Set(cast.ToFloat64(val))
+		P.labelTTLs.setTTL(appfwProfileViolationCollection, P.nsInstance, ss.Name, violation)
+	}
+}
+
+var appfwCollection = gaugeCollection{
+	appfwRequests,
+	appfwResponses,
+	appfwAborted,
+	appfwRedirects,
+	appfwViolationsTotal,
+}
+
+var appfwViolationCollection = gaugeCollection{
+	appfwViolations,
+}
+
+var appfwProfileCollection = gaugeCollection{
+	appfwProfileRequests,
+	appfwProfileResponses,
+	appfwProfileAborted,
+	appfwProfileRedirects,
+	appfwProfileViolationsTotal,
+}
+
+var appfwProfileViolationCollection = gaugeCollection{
+	appfwProfileViolations,
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawCacheStats is the payload as returned by the Nitro API.
+type RawCacheStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawCacheStats) Len() int {
+	return len(r)
+}
+
+// RawCMPStats is the payload as returned by the Nitro API.
+type RawCMPStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawCMPStats) Len() int {
+	return len(r)
+}
+
+// CacheStats represents the data returned from the /stat/cache Nitro API endpoint
+type CacheStats struct {
+	TotalHits        string  `json:"cachetothits"`
+	TotalMisses      string  `json:"cachetotmisses"`
+	TotalRequests    string  `json:"cachetotrequests"`
+	HitPct           float64 `json:"cachepercenthit"`
+	StoredObjects    string  `json:"cachenumcached"`
+	UtilizedMemoryKB string  `json:"cacheutilizedmemorykb"`
+	MaxMemoryKB      string  `json:"cachemaxmemorykb"`
+}
+
+// CMPStats represents the data returned from the /stat/cmp Nitro API endpoint
+type CMPStats struct {
+	TotalRequests    string  `json:"comptotalrequests"`
+	TotalRxBytes     string  `json:"comptotalrxbytes"`
+	TotalTxBytes     string  `json:"comptotaltxbytes"`
+	CompressionRatio float64 `json:"comptotaldatacompressionratio"`
+	BandwidthSaving  float64 `json:"comptcpbandwidthsaving"`
+}
+
+// NitroType implements the NitroData interface.
+func (s CacheStats) NitroType() string {
+	return cacheSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s CMPStats) NitroType() string {
+	return cmpSubsystem
+}
+
+func processCacheStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := cacheSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`cache`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawCacheStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+func processCMPStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := cmpSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`cmp`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawCMPStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
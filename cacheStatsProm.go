@@ -0,0 +1,190 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/cache/cache/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/cmp/cmp/
+
+const (
+	cacheSubsystem = `cache`
+	cmpSubsystem   = `cmp`
+)
+
+var (
+	cacheLabels = []string{netscalerInstance}
+	cacheHits   = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "hits_total",
+			Help:      "Total number of requests served from the integrated cache",
+		},
+		cacheLabels,
+	)
+
+	cacheMisses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "misses_total",
+			Help:      "Total number of cacheable requests that were not served from the integrated cache",
+		},
+		cacheLabels,
+	)
+
+	cacheRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests examined by the integrated cache",
+		},
+		cacheLabels,
+	)
+
+	cacheHitPct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "hit_ratio_pct",
+			Help:      "Percentage of requests served from the integrated cache",
+		},
+		cacheLabels,
+	)
+
+	cacheStoredObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "stored_objects",
+			Help:      "Number of objects currently stored in the integrated cache",
+		},
+		cacheLabels,
+	)
+
+	cacheUsedMemBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "memory_used_bytes",
+			Help:      "Memory currently used by the integrated cache",
+		},
+		cacheLabels,
+	)
+
+	cacheMaxMemBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "memory_max_bytes",
+			Help:      "Maximum memory the integrated cache is allowed to use",
+		},
+		cacheLabels,
+	)
+)
+
+var (
+	cmpLabels   = []string{netscalerInstance}
+	cmpRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cmpSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of HTTP compression requests",
+		},
+		cmpLabels,
+	)
+
+	cmpRxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cmpSubsystem,
+			Name:      "uncompressed_bytes_total",
+			Help:      "Total number of bytes received for compression",
+		},
+		cmpLabels,
+	)
+
+	cmpTxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cmpSubsystem,
+			Name:      "compressed_bytes_total",
+			Help:      "Total number of compressed bytes transmitted",
+		},
+		cmpLabels,
+	)
+
+	cmpBytesSaved = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cmpSubsystem,
+			Name:      "bytes_saved_total",
+			Help:      "Total number of bytes saved by compression",
+		},
+		cmpLabels,
+	)
+
+	cmpRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cmpSubsystem,
+			Name:      "compression_ratio",
+			Help:      "Ratio of uncompressed data received to compressed data transmitted",
+		},
+		cmpLabels,
+	)
+
+	cmpBandwidthSavingPct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cmpSubsystem,
+			Name:      "bandwidth_saving_pct",
+			Help:      "Bandwidth saving from compression expressed as a percentage",
+		},
+		cmpLabels,
+	)
+)
+
+func (P *Pool) promCacheStats(ss CacheStats) {
+	cacheHits.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalHits))
+	cacheMisses.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalMisses))
+	cacheRequests.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRequests))
+	cacheHitPct.WithLabelValues(P.nsInstance).Set(ss.HitPct)
+	cacheStoredObjects.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.StoredObjects))
+	cacheUsedMemBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.UtilizedMemoryKB) * 1024)
+	cacheMaxMemBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.MaxMemoryKB) * 1024)
+	P.labelTTLs.setTTL(cacheCollection, P.nsInstance)
+}
+
+func (P *Pool) promCMPStats(ss CMPStats) {
+	cmpRequests.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRequests))
+	cmpRxBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRxBytes))
+	cmpTxBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalTxBytes))
+	cmpBytesSaved.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRxBytes) - cast.ToFloat64(ss.TotalTxBytes))
+	cmpRatio.WithLabelValues(P.nsInstance).Set(ss.CompressionRatio)
+	cmpBandwidthSavingPct.WithLabelValues(P.nsInstance).Set(ss.BandwidthSaving)
+	P.labelTTLs.setTTL(cmpCollection, P.nsInstance)
+}
+
+var cacheCollection = gaugeCollection{
+	cacheHits,
+	cacheMisses,
+	cacheRequests,
+	cacheHitPct,
+	cacheStoredObjects,
+	cacheUsedMemBytes,
+	cacheMaxMemBytes,
+}
+
+var cmpCollection = gaugeCollection{
+	cmpRequests,
+	cmpRxBytes,
+	cmpTxBytes,
+	cmpBytesSaved,
+	cmpRatio,
+	cmpBandwidthSavingPct,
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// licensedFeatures maps the /config/nslicense fields exported by the capacity subsystem to their feature names.
+var licensedFeatures = map[string]string{
+	`lb`:        `load_balancing`,
+	`cs`:        `content_switching`,
+	`ssl`:       `ssl_offloading`,
+	`gslb`:      `gslb`,
+	`cmp`:       `compression`,
+	`ic`:        `integrated_caching`,
+	`rewrite`:   `rewrite`,
+	`responder`: `responder`,
+	`appfw`:     `application_firewall`,
+	`aaa`:       `aaa`,
+	`sslvpn`:    `ssl_vpn`,
+	`lsn`:       `lsn`,
+	`cluster`:   `cluster`,
+}
+
+// CapacityStats represents the licensed capacity merged from the /config/nscapacity, /config/nslicense and /stat/ns Nitro API endpoints
+type CapacityStats struct {
+	Bandwidth   NitroNumber     `json:"bandwidth"`
+	Unit        string          `json:"unit"`
+	Edition     string          `json:"edition"`
+	RxMbitsRate float64         `json:"-"`
+	TxMbitsRate float64         `json:"-"`
+	Features    map[string]bool `json:"-"`
+}
+
+// NitroType implements the NitroData interface.
+func (s CapacityStats) NitroType() string {
+	return capacitySubsystem
+}
+
+// BandwidthMbits returns the licensed bandwidth in megabits per second.
+func (s CapacityStats) BandwidthMbits() float64 {
+	switch strings.ToUpper(s.Unit) {
+	case `GBPS`:
+		return s.Bandwidth.Value() * 1000
+	default:
+		return s.Bandwidth.Value()
+	}
+}
+
+// ThroughputMbits returns the current throughput in megabits per second, taken as the higher of the receive and transmit rates.
+func (s CapacityStats) ThroughputMbits() float64 {
+	if s.RxMbitsRate > s.TxMbitsRate {
+		return s.RxMbitsRate
+	}
+	return s.TxMbitsRate
+}
+
+func processCapacityStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := capacitySubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			capacity, err := GetCapacityStats(P)
+			switch {
+			case err != nil:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS), zap.Error(err))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroDataReq(capacity)
+				success := P.submit(req)
+				if !success {
+					exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				}
+				go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+				timeEnd := time.Now().UnixNano()
+				exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+// GetCapacityStats retrieves the licensed bandwidth and features along with the current throughput.
+func GetCapacityStats(P *Pool) (CapacityStats, error) {
+	var capacity CapacityStats
+	b := submitAPITask(P, P.nitroConfig(`nscapacity`))
+	if len(b) < 1 {
+		return capacity, fmt.Errorf("error receiving data")
+	}
+	tmp := struct {
+		Target *CapacityStats `json:"nscapacity"`
+	}{Target: &capacity}
+	err := json.Unmarshal(b, &tmp)
+	if err != nil {
+		return capacity, err
+	}
+	b = submitAPITask(P, P.nitroConfig(`nslicense`))
+	if len(b) < 1 {
+		return capacity, fmt.Errorf("error receiving data")
+	}
+	var license map[string]interface{}
+	tmpLic := struct {
+		Target *map[string]interface{} `json:"nslicense"`
+	}{Target: &license}
+	err = json.Unmarshal(b, &tmpLic)
+	if err != nil {
+		return capacity, err
+	}
+	capacity.Features = make(map[string]bool, len(licensedFeatures))
+	for key, feature := range licensedFeatures {
+		if licensed, ok := license[key].(bool); ok {
+			capacity.Features[feature] = licensed
+		}
+	}
+	b = submitAPITask(P, P.nitroStat(`ns`))
+	if len(b) < 1 {
+		return capacity, fmt.Errorf("error receiving data")
+	}
+	var rates struct {
+		RxMbitsRate NitroNumber `json:"rxmbitsrate"`
+		TxMbitsRate NitroNumber `json:"txmbitsrate"`
+	}
+	tmpNS := struct {
+		Target interface{} `json:"ns"`
+	}{Target: &rates}
+	err = json.Unmarshal(b, &tmpNS)
+	if err != nil {
+		return capacity, err
+	}
+	capacity.RxMbitsRate = rates.RxMbitsRate.Value()
+	capacity.TxMbitsRate = rates.TxMbitsRate.Value()
+	return capacity, nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/ns/nscapacity/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/ns/nslicense/
+
+const capacitySubsystem = `capacity`
+
+var (
+	capacityLabels            = []string{netscalerInstance, `citrixadc_license_edition`}
+	capacityLicensedBandwidth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: capacitySubsystem,
+			Name:      "licensed_bandwidth_bits",
+			Help:      "Licensed throughput of the appliance in bits per second",
+		},
+		capacityLabels,
+	)
+
+	capacityThroughput = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: capacitySubsystem,
+			Name:      "throughput_bits",
+			Help:      "Current throughput of the appliance in bits per second, the higher of receive and transmit",
+		},
+		capacityLabels,
+	)
+
+	capacityUsagePct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: capacitySubsystem,
+			Name:      "throughput_license_usage_pct",
+			Help:      "Current throughput as a percentage of the licensed throughput",
+		},
+		capacityLabels,
+	)
+)
+
+var (
+	capacityFeatureLabels   = []string{netscalerInstance, `citrixadc_license_feature`}
+	capacityFeatureLicensed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: capacitySubsystem,
+			Name:      "feature_licensed",
+			Help:      "Whether the feature is licensed on the appliance. 0 = NOT LICENSED, 1 = LICENSED",
+		},
+		capacityFeatureLabels,
+	)
+)
+
+func (P *Pool) promCapacityStats(ss CapacityStats) {
+	// Values are in megabits. Convert to base unit of bits.
+	bandwidth := ss.BandwidthMbits() * 1000 * 1000
+	throughput := ss.ThroughputMbits() * 1000 * 1000
+	capacityLicensedBandwidth.WithLabelValues(P.nsInstance, ss.Edition).Set(bandwidth)
+	capacityThroughput.WithLabelValues(P.nsInstance, ss.Edition).Set(throughput)
+	if bandwidth > 0 {
+		capacityUsagePct.WithLabelValues(P.nsInstance, ss.Edition).Set(throughput / bandwidth * 100)
+		P.labelTTLs.setTTL(capacityUsageCollection, P.nsInstance, ss.Edition)
+	}
+	P.labelTTLs.setTTL(capacityCollection, P.nsInstance, ss.Edition)
+	for feature, licensed := range ss.Features {
+		var val float64
+		if licensed {
+			val = 1
+		}
+		capacityFeatureLicensed.WithLabelValues(P.nsInstance, feature).Set(val)
+		P.labelTTLs.setTTL(capacityFeatureCollection, P.nsInstance, feature)
+	}
+}
+
+var capacityCollection = gaugeCollection{
+	capacityLicensedBandwidth,
+	capacityThroughput,
+}
+
+var capacityUsageCollection = gaugeCollection{
+	capacityUsagePct,
+}
+
+var capacityFeatureCollection = gaugeCollection{
+	capacityFeatureLicensed,
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawClusterNodeStats is the payload as returned by the Nitro API.
+type RawClusterNodeStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawClusterNodeStats) Len() int {
+	return len(r)
+}
+
+// RawClusterInstanceStats is the payload as returned by the Nitro API.
+type RawClusterInstanceStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawClusterInstanceStats) Len() int {
+	return len(r)
+}
+
+// ClusterNodeStats represents the data returned from the /stat/clusternode Nitro API endpoint
+type ClusterNodeStats struct {
+	ID               NitroNumber  `json:"nodeid"`
+	IPAddress        string       `json:"clnodeip"`
+	Health           CurState     `json:"clnodehealth"`
+	EffectiveHealth  CurState     `json:"clnodeeffectivehealth"`
+	MasterState      ClusterState `json:"clmasterstate"`
+	SyncState        string       `json:"clsyncstate"`
+	BackplaneRxBytes string       `json:"clbkplanerx"`
+	BackplaneTxBytes string       `json:"clbkplanetx"`
+}
+
+// ClusterInstanceStats represents the data returned from the /stat/clusterinstance Nitro API endpoint
+type ClusterInstanceStats struct {
+	ID     NitroNumber `json:"clid"`
+	Status CurState    `json:"clcurstatus"`
+}
+
+// ClusterState is the operational state of a cluster node as returned by the Nitro API.
+type ClusterState string
+
+// Value returns the value mapping for the ClusterState.
+func (c ClusterState) Value() float64 {
+	switch c {
+	case `INACTIVE`:
+		return 0.0
+	case `ACTIVE`:
+		return 1.0
+	case `SPARE`:
+		return 2.0
+	default:
+		return 3.0
+	}
+}
+
+// NitroType implements the NitroData interface.
+func (s ClusterNodeStats) NitroType() string {
+	return clusterSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s ClusterInstanceStats) NitroType() string {
+	return clusterSubsystem
+}
+
+func processClusterStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := clusterSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			nodeData := submitAPITask(P, P.nitroStat(`clusternode`))
+			instanceData := submitAPITask(P, P.nitroStat(`clusterinstance`))
+			switch {
+			case len(nodeData) < 1 || len(instanceData) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				noErr := true
+				for _, raw := range []NitroRaw{RawClusterNodeStats(nodeData), RawClusterInstanceStats(instanceData)} {
+					req := newNitroRawReq(raw)
+					P.submit(req)
+					s := <-req.ResultChan()
+					if success, ok := s.(bool); !ok || !success {
+						noErr = false
+					}
+				}
+				switch {
+				case noErr:
+					go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+					timeEnd := time.Now().UnixNano()
+					exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				default:
+					exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/cluster/clusternode/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/cluster/clusterinstance/
+
+const clusterSubsystem = `cluster`
+
+var (
+	clusterInstanceLabels = []string{netscalerInstance, `citrixadc_cluster_id`}
+	clusterInstanceStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "status",
+			Help:      "Current status of the cluster instance. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		clusterInstanceLabels,
+	)
+)
+
+var (
+	clusterNodeLabels = []string{netscalerInstance, `citrixadc_cluster_node_id`, `citrixadc_cluster_node_ip`}
+	clusterNodeHealth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "node_health",
+			Help:      "Health of the cluster node. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		clusterNodeLabels,
+	)
+
+	clusterNodeEffectiveHealth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "node_effective_health",
+			Help:      "Effective health of the cluster node, taking into account the health of its interfaces. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		clusterNodeLabels,
+	)
+
+	clusterNodeState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "node_state",
+			Help:      "Operational state of the cluster node. 0 = INACTIVE, 1 = ACTIVE, 2 = SPARE, 3 = UNKNOWN",
+		},
+		clusterNodeLabels,
+	)
+
+	clusterNodeBackplaneRxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "node_backplane_received_bytes_total",
+			Help:      "Number of bytes received by the cluster node on the backplane",
+		},
+		clusterNodeLabels,
+	)
+
+	clusterNodeBackplaneTxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "node_backplane_transmitted_bytes_total",
+			Help:      "Number of bytes transmitted by the cluster node on the backplane",
+		},
+		clusterNodeLabels,
+	)
+)
+
+var (
+	clusterNodeSyncLabels = []string{netscalerInstance, `citrixadc_cluster_node_id`, `citrixadc_cluster_node_ip`, `citrixadc_cluster_sync_state`}
+	clusterNodeSyncInfo   = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: clusterSubsystem,
+			Name:      "node_sync_info",
+			Help:      "A metric with a constant '1' value labeled with the configuration synchronization state of the cluster node.",
+		},
+		clusterNodeSyncLabels,
+	)
+)
+
+func (P *Pool) promClusterStats(N NitroData) {
+	switch ss := N.(type) {
+	case ClusterInstanceStats:
+		clusterInstanceStatus.WithLabelValues(P.nsInstance, string(ss.ID)).Set(ss.Status.Value())
+		P.labelTTLs.setTTL(clusterInstanceCollection, P.nsInstance, string(ss.ID))
+	case ClusterNodeStats:
+		clusterNodeHealth.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress).Set(ss.Health.Value())
+		clusterNodeEffectiveHealth.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress).Set(ss.EffectiveHealth.Value())
+		clusterNodeState.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress).Set(ss.MasterState.Value())
+		clusterNodeBackplaneRxBytes.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress).Set(cast.ToFloat64(ss.BackplaneRxBytes))
+		clusterNodeBackplaneTxBytes.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress).Set(cast.ToFloat64(ss.BackplaneTxBytes))
+		P.labelTTLs.setTTL(clusterNodeCollection, P.nsInstance, string(ss.ID), ss.IPAddress)
+		clusterNodeSyncInfo.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress, ss.SyncState).Set(1)
+		P.labelTTLs.setCurrent(clusterNodeSyncCollection, string(ss.ID), P.nsInstance, string(ss.ID), ss.IPAddress, ss.SyncState)
+	}
+}
+
+var clusterInstanceCollection = gaugeCollection{
+	clusterInstanceStatus,
+}
+
+var clusterNodeCollection = gaugeCollection{
+	clusterNodeHealth,
+	clusterNodeEffectiveHealth,
+	clusterNodeState,
+	clusterNodeBackplaneRxBytes,
+	clusterNodeBackplaneTxBytes,
+}
+
+var clusterNodeSyncCollection = gaugeCollection{
+	clusterNodeSyncInfo,
+}
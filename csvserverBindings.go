@@ -0,0 +1,95 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawCSVServerPolicyBindings is the payload as returned by the Nitro API.
+type RawCSVServerPolicyBindings []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawCSVServerPolicyBindings) Len() int {
+	return len(r)
+}
+
+// RawCSVServerLBVServerBindings is the payload as returned by the Nitro API.
+type RawCSVServerLBVServerBindings []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawCSVServerLBVServerBindings) Len() int {
+	return len(r)
+}
+
+// CSVServerPolicyBindings represents the data returned from the /config/csvserver_cspolicy_binding Nitro API endpoint
+type CSVServerPolicyBindings struct {
+	Name            string      `json:"name"`
+	PolicyName      string      `json:"policyname"`
+	TargetLBVServer string      `json:"targetlbvserver"`
+	Hits            NitroNumber `json:"hits"`
+}
+
+// CSVServerLBVServerBindings represents the data returned from the /config/csvserver_lbvserver_binding Nitro API endpoint
+type CSVServerLBVServerBindings struct {
+	Name      string `json:"name"`
+	LBVServer string `json:"lbvserver"`
+}
+
+// NitroType implements the NitroData interface.
+func (s CSVServerPolicyBindings) NitroType() string {
+	return csvserverPolicySubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s CSVServerLBVServerBindings) NitroType() string {
+	return csvserverPolicySubsystem
+}
+
+func processCSVServerPolicies(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := csvserverPolicySubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			policyData := submitAPITask(P, P.nitroConfig(`csvserver_cspolicy_binding?bulkbindings=yes`))
+			lbData := submitAPITask(P, P.nitroConfig(`csvserver_lbvserver_binding?bulkbindings=yes`))
+			switch {
+			case len(policyData) < 1 || len(lbData) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				noErr := true
+				for _, raw := range []NitroRaw{RawCSVServerPolicyBindings(policyData), RawCSVServerLBVServerBindings(lbData)} {
+					req := newNitroRawReq(raw)
+					P.submit(req)
+					s := <-req.ResultChan()
+					if success, ok := s.(bool); !ok || !success {
+						noErr = false
+					}
+				}
+				switch {
+				case noErr:
+					go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+					timeEnd := time.Now().UnixNano()
+					exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				default:
+					exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
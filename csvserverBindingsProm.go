@@ -0,0 +1,56 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/content-switching/csvserver_cspolicy_binding/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/content-switching/csvserver_lbvserver_binding/
+
+const csvserverPolicySubsystem = `csvserver_policy`
+
+var (
+	csvserverPolicyLabels    = []string{netscalerInstance, `citrixadc_cs_name`, `citrixadc_cs_policy_name`, `citrixadc_lb_name`}
+	csvserverPolicyTotalHits = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "policy_hits_total",
+			Help:      "Number of hits for the content switching policy bound to this virtual server",
+		},
+		csvserverPolicyLabels,
+	)
+
+	csvserverTargetInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "target_info",
+			Help:      "A metric with a constant '1' value linking a content switching virtual server to a target lbvserver. An empty policy name indicates the default lbvserver.",
+		},
+		csvserverPolicyLabels,
+	)
+)
+
+func (P *Pool) promCSVServerBindings(N NitroData) {
+	switch ss := N.(type) {
+	case CSVServerPolicyBindings:
+		csvserverPolicyTotalHits.WithLabelValues(P.nsInstance, ss.Name, ss.PolicyName, ss.TargetLBVServer).Set(ss.Hits.Value())
+		P.labelTTLs.setCurrent(csvserverPolicyCollection, ss.Name+`/`+ss.PolicyName, P.nsInstance, ss.Name, ss.PolicyName, ss.TargetLBVServer)
+		if ss.TargetLBVServer != "" {
+			csvserverTargetInfo.WithLabelValues(P.nsInstance, ss.Name, ss.PolicyName, ss.TargetLBVServer).Set(1)
+			P.labelTTLs.setCurrent(csvserverTargetCollection, ss.Name+`/`+ss.PolicyName, P.nsInstance, ss.Name, ss.PolicyName, ss.TargetLBVServer)
+		}
+	case CSVServerLBVServerBindings:
+		csvserverTargetInfo.WithLabelValues(P.nsInstance, ss.Name, "", ss.LBVServer).Set(1)
+		P.labelTTLs.setCurrent(csvserverTargetCollection, ss.Name+`/`, P.nsInstance, ss.Name, "", ss.LBVServer)
+	}
+}
+
+var csvserverPolicyCollection = gaugeCollection{
+	csvserverPolicyTotalHits,
+}
+
+var csvserverTargetCollection = gaugeCollection{
+	csvserverTargetInfo,
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jbvmio/netscaler"
+	"go.uber.org/zap"
+)
+
+// RawCSVServerStats is the payload as returned by the Nitro API.
+type RawCSVServerStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawCSVServerStats) Len() int {
+	return len(r)
+}
+
+// CSVServerStats represents the data returned from the /stat/csvserver Nitro API endpoint
+type CSVServerStats struct {
+	Name                     string   `json:"name"`
+	State                    CurState `json:"state"`
+	EstablishedConnections   string   `json:"establishedconn"`
+	CurrentClientConnections string   `json:"curclntconnections"`
+	CurrentServerConnections string   `json:"cursrvrconnections"`
+	TotalHits                string   `json:"tothits"`
+	TotalRequests            string   `json:"totalrequests"`
+	TotalResponses           string   `json:"totalresponses"`
+	TotalRequestBytes        string   `json:"totalrequestbytes"`
+	TotalResponseBytes       string   `json:"totalresponsebytes"`
+	TotalPktsReceived        string   `json:"totalpktsrecvd"`
+	TotalPktsSent            string   `json:"totalpktssent"`
+	Type                     string   `json:"type"`
+}
+
+// NitroType implements the NitroData interface.
+func (s CSVServerStats) NitroType() string {
+	return csvserverSubsystem
+}
+
+func processCSVServerStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := csvserverSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, netscaler.StatsTypeCSVServer)
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawCSVServerStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
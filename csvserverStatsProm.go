@@ -0,0 +1,152 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/content-switching/csvserver/
+
+const csvserverSubsystem = `csvserver`
+
+var (
+	csvserverLabels = []string{netscalerInstance, `citrixadc_cs_name`, `citrixadc_cs_type`}
+	csvserverState  = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "state",
+			Help:      "Current state of the server. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		csvserverLabels,
+	)
+
+	csvserverEstablishedConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "established_connections",
+			Help:      "Number of client connections in ESTABLISHED state",
+		},
+		csvserverLabels,
+	)
+
+	csvserverCurrentClientConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "current_client_connections",
+			Help:      "Number of current client connections",
+		},
+		csvserverLabels,
+	)
+
+	csvserverCurrentServerConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "current_server_connections",
+			Help:      "Number of current connections to the actual servers behind the virtual server",
+		},
+		csvserverLabels,
+	)
+
+	csvserverTotalHits = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "hits_total",
+			Help:      "Total vserver hits",
+		},
+		csvserverLabels,
+	)
+
+	csvserverTotalRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests received on this virtual server",
+		},
+		csvserverLabels,
+	)
+
+	csvserverTotalResponses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of responses received on this virtual server",
+		},
+		csvserverLabels,
+	)
+
+	csvserverTotalRequestBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "request_bytes_total",
+			Help:      "Total number of request bytes received on this virtual server",
+		},
+		csvserverLabels,
+	)
+
+	csvserverTotalResponseBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total number of response bytes received on this virtual server",
+		},
+		csvserverLabels,
+	)
+
+	csvserverTotalPktsRx = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "pkts_received_total",
+			Help:      "Total number of packets received on this virtual server",
+		},
+		csvserverLabels,
+	)
+
+	csvserverTotalPktsTx = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: csvserverSubsystem,
+			Name:      "pkts_sent_total",
+			Help:      "Total number of packets sent on this virtual server",
+		},
+		csvserverLabels,
+	)
+)
+
+func (P *Pool) promCSVServerStats(ss CSVServerStats) {
+	csvserverState.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(ss.State.Value())
+	csvserverEstablishedConns.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.EstablishedConnections))
+	csvserverCurrentClientConns.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.CurrentClientConnections))
+	csvserverCurrentServerConns.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.CurrentServerConnections))
+	csvserverTotalHits.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalHits))
+	csvserverTotalRequests.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalRequests))
+	csvserverTotalResponses.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalResponses))
+	csvserverTotalRequestBytes.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalRequestBytes))
+	csvserverTotalResponseBytes.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalResponseBytes))
+	csvserverTotalPktsRx.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalPktsReceived))
+	csvserverTotalPktsTx.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalPktsSent))
+	P.labelTTLs.setTTL(csvserverStatCollection, P.nsInstance, ss.Name, ss.Type)
+}
+
+var csvserverStatCollection = gaugeCollection{
+	csvserverState,
+	csvserverEstablishedConns,
+	csvserverCurrentClientConns,
+	csvserverCurrentServerConns,
+	csvserverTotalHits,
+	csvserverTotalRequests,
+	csvserverTotalResponses,
+	csvserverTotalRequestBytes,
+	csvserverTotalResponseBytes,
+	csvserverTotalPktsRx,
+	csvserverTotalPktsTx,
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawDNSStats is the payload as returned by the Nitro API.
+type RawDNSStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawDNSStats) Len() int {
+	return len(r)
+}
+
+// RawGSLBDomainStats is the payload as returned by the Nitro API.
+type RawGSLBDomainStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawGSLBDomainStats) Len() int {
+	return len(r)
+}
+
+// DNSStats represents the data returned from the /stat/dns Nitro API endpoint
+type DNSStats struct {
+	TotalQueries   string `json:"dnstotqueries"`
+	TotalAnswers   string `json:"dnstotanswers"`
+	TotalNXDomain  string `json:"dnserrnodomain"`
+	TotalCacheHits string `json:"dnstotcachehits"`
+	AQueries       string `json:"dnstotaqueries"`
+	AAAAQueries    string `json:"dnstotaaaaqueries"`
+	CNAMEQueries   string `json:"dnstotcnamequeries"`
+	MXQueries      string `json:"dnstotmxqueries"`
+	NSQueries      string `json:"dnstotnsqueries"`
+	SOAQueries     string `json:"dnstotsoaqueries"`
+	PTRQueries     string `json:"dnstotptrqueries"`
+	SRVQueries     string `json:"dnstotsrvqueries"`
+	ANYQueries     string `json:"dnstotanyqueries"`
+	AResponses     string `json:"dnstotaresponse"`
+	AAAAResponses  string `json:"dnstotaaaaresponse"`
+	CNAMEResponses string `json:"dnstotcnameresponse"`
+	MXResponses    string `json:"dnstotmxresponse"`
+	NSResponses    string `json:"dnstotnsresponse"`
+	SOAResponses   string `json:"dnstotsoaresponse"`
+	PTRResponses   string `json:"dnstotptrresponse"`
+	SRVResponses   string `json:"dnstotsrvresponse"`
+	ANYResponses   string `json:"dnstotanyresponse"`
+}
+
+// GSLBDomainStats represents the data returned from the /stat/gslbdomain Nitro API endpoint
+type GSLBDomainStats struct {
+	Name         string `json:"name"`
+	TotalQueries string `json:"dnstotalquery"`
+}
+
+// NitroType implements the NitroData interface.
+func (s DNSStats) NitroType() string {
+	return dnsSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s GSLBDomainStats) NitroType() string {
+	return gslbDomainSubsystem
+}
+
+func processDNSStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := dnsSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`dns`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawDNSStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+func processGSLBDomainStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := gslbDomainSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`gslbdomain`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawGSLBDomainStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/dns/dns/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/gslb/gslbdomain/
+
+const (
+	dnsSubsystem        = `dns`
+	gslbDomainSubsystem = `gslbdomain`
+)
+
+var (
+	dnsLabels  = []string{netscalerInstance}
+	dnsQueries = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "queries_total",
+			Help:      "Total number of DNS queries received",
+		},
+		dnsLabels,
+	)
+
+	dnsAnswers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "answers_total",
+			Help:      "Total number of DNS answers sent",
+		},
+		dnsLabels,
+	)
+
+	dnsNXDomain = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "nxdomain_total",
+			Help:      "Total number of DNS queries for which no record was found",
+		},
+		dnsLabels,
+	)
+
+	dnsCacheHits = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "cache_hits_total",
+			Help:      "Total number of DNS queries answered from the DNS cache",
+		},
+		dnsLabels,
+	)
+)
+
+var (
+	dnsRecordLabels  = []string{netscalerInstance, `citrixadc_dns_record_type`}
+	dnsRecordQueries = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "record_queries_total",
+			Help:      "Total number of DNS queries received by record type",
+		},
+		dnsRecordLabels,
+	)
+
+	dnsRecordResponses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "record_responses_total",
+			Help:      "Total number of DNS responses sent by record type",
+		},
+		dnsRecordLabels,
+	)
+)
+
+var (
+	gslbDomainLabels  = []string{netscalerInstance, `citrixadc_gslb_domain`}
+	gslbDomainQueries = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbDomainSubsystem,
+			Name:      "queries_total",
+			Help:      "Total number of DNS queries received for this GSLB domain",
+		},
+		gslbDomainLabels,
+	)
+)
+
+func (P *Pool) promDNSStats(ss DNSStats) {
+	dnsQueries.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalQueries))
+	dnsAnswers.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalAnswers))
+	dnsNXDomain.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalNXDomain))
+	dnsCacheHits.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalCacheHits))
+	P.labelTTLs.setTTL(dnsCollection, P.nsInstance)
+
+	for recordType, counts := range map[string][2]string{
+		`A`:     {ss.AQueries, ss.AResponses},
+		`AAAA`:  {ss.AAAAQueries, ss.AAAAResponses},
+		`CNAME`: {ss.CNAMEQueries, ss.CNAMEResponses},
+		`MX`:    {ss.MXQueries, ss.MXResponses},
+		`NS`:    {ss.NSQueries, ss.NSResponses},
+		`SOA`:   {ss.SOAQueries, ss.SOAResponses},
+		`PTR`:   {ss.PTRQueries, ss.PTRResponses},
+		`SRV`:   {ss.SRVQueries, ss.SRVResponses},
+		`ANY`:   {ss.ANYQueries, ss.ANYResponses},
+	} {
+		dnsRecordQueries.WithLabelValues(P.nsInstance, recordType).Set(cast.ToFloat64(counts[0]))
+		dnsRecordResponses.WithLabelValues(P.nsInstance, recordType).Set(cast.ToFloat64(counts[1]))
+		P.labelTTLs.setTTL(dnsRecordCollection, P.nsInstance, recordType)
+	}
+}
+
+func (P *Pool) promGSLBDomainStats(ss GSLBDomainStats) {
+	gslbDomainQueries.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalQueries))
+	P.labelTTLs.setTTL(gslbDomainCollection, P.nsInstance, ss.Name)
+}
+
+var dnsCollection = gaugeCollection{
+	dnsQueries,
+	dnsAnswers,
+	dnsNXDomain,
+	dnsCacheHits,
+}
+
+var dnsRecordCollection = gaugeCollection{
+	dnsRecordQueries,
+	dnsRecordResponses,
+}
+
+var gslbDomainCollection = gaugeCollection{
+	gslbDomainQueries,
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// GSLBSiteStats represents the data returned from the /stat/gslbsite Nitro API endpoint
+type GSLBSiteStats struct {
+	Name                 string    `json:"sitename"`
+	IPAddress            string    `json:"siteip"`
+	MEPStatus            MEPStatus `json:"sitemetricmepstatus"`
+	TotalRequests        string    `json:"sitetotalrequests"`
+	TotalResponses       string    `json:"sitetotalresponses"`
+	MEPReceivedBytes     string    `json:"sitemeprxbytes"`
+	MEPSentBytes         string    `json:"sitemeptxbytes"`
+	SiteType             string    `json:"-"`
+	MetricExchange       string    `json:"-"`
+	SessionExchange      string    `json:"-"`
+	PersistenceMEPStatus MEPStatus `json:"-"`
+}
+
+// GSLBSiteConfigs represents the data returned from the /config/gslbsite Nitro API endpoint
+type GSLBSiteConfigs struct {
+	Name                 string    `json:"sitename"`
+	SiteType             string    `json:"sitetype"`
+	MetricExchange       string    `json:"metricexchange"`
+	SessionExchange      string    `json:"sessionexchange"`
+	PersistenceMEPStatus MEPStatus `json:"persistencemepstatus"`
+}
+
+// NitroType implements the NitroData interface.
+func (s GSLBSiteStats) NitroType() string {
+	return gslbSiteSubsystem
+}
+
+// MEPStatus is the metric exchange protocol connection status as returned by the Nitro API.
+type MEPStatus string
+
+// Value returns the value mapping for the MEPStatus.
+func (m MEPStatus) Value() float64 {
+	switch m {
+	case `INACTIVE`, `DOWN`:
+		return 0.0
+	case `ACTIVE`, `UP`:
+		return 1.0
+	case `DISABLED`:
+		return 2.0
+	default:
+		return 3.0
+	}
+}
+
+func processGSLBSiteStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := gslbSiteSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			sites, err := GetGSLBSiteStats(P)
+			switch {
+			case err != nil:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS), zap.Error(err))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				P.logger.Debug("processing gslbsite stats", zap.String("subSystem", thisSS), zap.Int("number of sites", len(sites)))
+				for _, site := range sites {
+					req := newNitroDataReq(site)
+					success := P.submit(req)
+					if !success {
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+				timeEnd := time.Now().UnixNano()
+				exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+// GetGSLBSiteStats retrieves stats for all GSLB sites along with their configured site type and exchange settings.
+func GetGSLBSiteStats(P *Pool) ([]GSLBSiteStats, error) {
+	var sites []GSLBSiteStats
+	var configs []GSLBSiteConfigs
+	b := submitAPITask(P, P.nitroStat(`gslbsite`))
+	if len(b) < 1 {
+		return sites, fmt.Errorf("error receiving data")
+	}
+	tmp := struct {
+		Target *[]GSLBSiteStats `json:"gslbsite"`
+	}{Target: &sites}
+	err := json.Unmarshal(b, &tmp)
+	if err != nil {
+		return sites, err
+	}
+	b = submitAPITask(P, P.nitroConfig(`gslbsite`))
+	if len(b) < 1 {
+		return sites, fmt.Errorf("error receiving data")
+	}
+	tmpCfg := struct {
+		Target *[]GSLBSiteConfigs `json:"gslbsite"`
+	}{Target: &configs}
+	err = json.Unmarshal(b, &tmpCfg)
+	if err != nil {
+		return sites, err
+	}
+	cfgMap := make(map[string]GSLBSiteConfigs, len(configs))
+	for _, c := range configs {
+		cfgMap[c.Name] = c
+	}
+	for i := range sites {
+		c := cfgMap[sites[i].Name]
+		sites[i].SiteType = c.SiteType
+		sites[i].MetricExchange = c.MetricExchange
+		sites[i].SessionExchange = c.SessionExchange
+		sites[i].PersistenceMEPStatus = c.PersistenceMEPStatus
+	}
+	return sites, nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/gslb/gslbsite/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/gslb/gslbsite/
+
+const gslbSiteSubsystem = `gslbsite`
+
+var (
+	gslbSiteLabels   = []string{netscalerInstance, `citrixadc_gslb_site_name`, `citrixadc_gslb_site_type`, `citrixadc_gslb_site_ip`}
+	gslbSiteMEPState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSiteSubsystem,
+			Name:      "mep_state",
+			Help:      "Metric exchange protocol connection status of the site. 0 = INACTIVE, 1 = ACTIVE, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		gslbSiteLabels,
+	)
+
+	gslbSitePersistenceMEPState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSiteSubsystem,
+			Name:      "persistence_mep_state",
+			Help:      "Network metric and persistence exchange connection status of the site. 0 = INACTIVE, 1 = ACTIVE, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		gslbSiteLabels,
+	)
+
+	gslbSiteMetricExchange = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSiteSubsystem,
+			Name:      "metric_exchange_enabled",
+			Help:      "Whether metric exchange is enabled for the site. 0 = DISABLED, 1 = ENABLED",
+		},
+		gslbSiteLabels,
+	)
+
+	gslbSiteSessionExchange = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSiteSubsystem,
+			Name:      "session_exchange_enabled",
+			Help:      "Whether persistence session exchange is enabled for the site. 0 = DISABLED, 1 = ENABLED",
+		},
+		gslbSiteLabels,
+	)
+
+	gslbSiteTotalRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSiteSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests received by the site",
+		},
+		gslbSiteLabels,
+	)
+
+	gslbSiteTotalResponses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSiteSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of responses sent by the site",
+		},
+		gslbSiteLabels,
+	)
+
+	gslbSiteMEPReceivedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSiteSubsystem,
+			Name:      "mep_received_bytes_total",
+			Help:      "Total number of metric exchange protocol bytes received from the site",
+		},
+		gslbSiteLabels,
+	)
+
+	gslbSiteMEPSentBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSiteSubsystem,
+			Name:      "mep_sent_bytes_total",
+			Help:      "Total number of metric exchange protocol bytes sent to the site",
+		},
+		gslbSiteLabels,
+	)
+)
+
+func (P *Pool) promGSLBSiteStats(ss GSLBSiteStats) {
+	var metricExchange, sessionExchange float64
+	if ss.MetricExchange == `ENABLED` {
+		metricExchange = 1
+	}
+	if ss.SessionExchange == `ENABLED` {
+		sessionExchange = 1
+	}
+	gslbSiteMEPState.WithLabelValues(P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress).Set(ss.MEPStatus.Value())
+	gslbSitePersistenceMEPState.WithLabelValues(P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress).Set(ss.PersistenceMEPStatus.Value())
+	gslbSiteMetricExchange.WithLabelValues(P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress).Set(metricExchange)
+	gslbSiteSessionExchange.WithLabelValues(P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress).Set(sessionExchange)
+	gslbSiteTotalRequests.WithLabelValues(P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress).Set(cast.ToFloat64(ss.TotalRequests))
+	gslbSiteTotalResponses.WithLabelValues(P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress).Set(cast.ToFloat64(ss.TotalResponses))
+	gslbSiteMEPReceivedBytes.WithLabelValues(P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress).Set(cast.ToFloat64(ss.MEPReceivedBytes))
+	gslbSiteMEPSentBytes.WithLabelValues(P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress).Set(cast.ToFloat64(ss.MEPSentBytes))
+	P.labelTTLs.setTTL(gslbSiteCollection, P.nsInstance, ss.Name, ss.SiteType, ss.IPAddress)
+}
+
+var gslbSiteCollection = gaugeCollection{
+	gslbSiteMEPState,
+	gslbSitePersistenceMEPState,
+	gslbSiteMetricExchange,
+	gslbSiteSessionExchange,
+	gslbSiteTotalRequests,
+	gslbSiteTotalResponses,
+	gslbSiteMEPReceivedBytes,
+	gslbSiteMEPSentBytes,
+}
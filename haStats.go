@@ -0,0 +1,115 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawHANodeStats is the payload as returned by the Nitro API.
+type RawHANodeStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawHANodeStats) Len() int {
+	return len(r)
+}
+
+// RawHANodeConfigs is the payload as returned by the Nitro API.
+type RawHANodeConfigs []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawHANodeConfigs) Len() int {
+	return len(r)
+}
+
+// HANodeStats represents the data returned from the /stat/hanode Nitro API endpoint
+type HANodeStats struct {
+	CurState           CurState `json:"hacurstate"`
+	CurMasterState     HARole   `json:"hacurmasterstate"`
+	TotalPktsReceived  string   `json:"hatotpktrx"`
+	TotalPktsSent      string   `json:"hatotpkttx"`
+	PropagationTimeout string   `json:"haerrproptimeout"`
+	SyncFailures       string   `json:"haerrsyncfailure"`
+}
+
+// HANodeConfigs represents the data returned from the /config/hanode Nitro API endpoint
+type HANodeConfigs struct {
+	ID        NitroNumber `json:"id"`
+	IPAddress string      `json:"ipaddress"`
+	State     HARole      `json:"state"`
+	HASync    string      `json:"hasync"`
+	CurFlips  NitroNumber `json:"curflips"`
+}
+
+// HARole is the master state of a HA node as returned by the Nitro API.
+type HARole string
+
+// Value returns the value mapping for the HARole.
+func (h HARole) Value() float64 {
+	switch h {
+	case `Secondary`:
+		return 0.0
+	case `Primary`:
+		return 1.0
+	default:
+		return 2.0
+	}
+}
+
+// NitroType implements the NitroData interface.
+func (s HANodeStats) NitroType() string {
+	return haSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s HANodeConfigs) NitroType() string {
+	return haSubsystem
+}
+
+func processHAStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := haSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			statData := submitAPITask(P, P.nitroStat(`hanode`))
+			configData := submitAPITask(P, P.nitroConfig(`hanode`))
+			switch {
+			case len(statData) < 1 || len(configData) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				noErr := true
+				for _, raw := range []NitroRaw{RawHANodeStats(statData), RawHANodeConfigs(configData)} {
+					req := newNitroRawReq(raw)
+					P.submit(req)
+					s := <-req.ResultChan()
+					if success, ok := s.(bool); !ok || !success {
+						noErr = false
+					}
+				}
+				switch {
+				case noErr:
+					go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+					timeEnd := time.Now().UnixNano()
+					exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				default:
+					exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
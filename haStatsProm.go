@@ -0,0 +1,161 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+	"go.uber.org/zap"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/ha/hanode/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/ha/hanode/
+
+const haSubsystem = `ha`
+
+var (
+	haLabels   = []string{netscalerInstance}
+	haCurState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "state",
+			Help:      "HA state of the queried node. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		haLabels,
+	)
+
+	haCurMasterState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "role",
+			Help:      "HA role of the queried node. 0 = SECONDARY, 1 = PRIMARY, 2 = UNKNOWN",
+		},
+		haLabels,
+	)
+
+	haTotalHeartbeatsRx = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "heartbeats_received_total",
+			Help:      "Number of heartbeat packets received from the peer node",
+		},
+		haLabels,
+	)
+
+	haTotalHeartbeatsTx = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "heartbeats_sent_total",
+			Help:      "Number of heartbeat packets sent to the peer node",
+		},
+		haLabels,
+	)
+
+	haTotalPropagationTimeouts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "propagation_timeouts_total",
+			Help:      "Number of times the propagation of a configuration command to the peer node timed out",
+		},
+		haLabels,
+	)
+
+	haTotalSyncFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "sync_failures_total",
+			Help:      "Number of times the configuration of the primary and secondary nodes failed to synchronize",
+		},
+		haLabels,
+	)
+
+	haRoleTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "role_transitions_total",
+			Help:      "The total number of HA role changes of the queried node observed by the exporter",
+		},
+		haLabels,
+	)
+)
+
+var (
+	haNodeLabels = []string{netscalerInstance, `citrixadc_ha_node_id`, `citrixadc_ha_node_ip`}
+	haNodeRole   = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "node_role",
+			Help:      "HA role of the node. 0 = SECONDARY, 1 = PRIMARY, 2 = UNKNOWN",
+		},
+		haNodeLabels,
+	)
+
+	haNodeSyncEnabled = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "node_sync_enabled",
+			Help:      "Whether HA configuration synchronization is enabled on the node. 0 = DISABLED, 1 = ENABLED",
+		},
+		haNodeLabels,
+	)
+
+	haNodeFlips = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: haSubsystem,
+			Name:      "node_flips",
+			Help:      "Number of HA state flips of the node within the configured maximum flip time",
+		},
+		haNodeLabels,
+	)
+)
+
+func (P *Pool) promHAStats(N NitroData) {
+	switch ss := N.(type) {
+	case HANodeStats:
+		haCurState.WithLabelValues(P.nsInstance).Set(ss.CurState.Value())
+		haCurMasterState.WithLabelValues(P.nsInstance).Set(ss.CurMasterState.Value())
+		haTotalHeartbeatsRx.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalPktsReceived))
+		haTotalHeartbeatsTx.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalPktsSent))
+		haTotalPropagationTimeouts.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.PropagationTimeout))
+		haTotalSyncFailures.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.SyncFailures))
+		P.labelTTLs.setTTL(haStatCollection, P.nsInstance)
+		haRoleTransitions.WithLabelValues(P.nsInstance).Add(0)
+		if last, ok := P.haRoles.Get(P.nsInstance).(HARole); ok && last != ss.CurMasterState {
+			P.logger.Warn("observed HA role transition", zap.String("previous", string(last)), zap.String("current", string(ss.CurMasterState)))
+			haRoleTransitions.WithLabelValues(P.nsInstance).Inc()
+		}
+		P.haRoles.Update(P.nsInstance, ss.CurMasterState)
+	case HANodeConfigs:
+		var syncEnabled float64
+		if ss.HASync == `ENABLED` {
+			syncEnabled = 1
+		}
+		haNodeRole.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress).Set(ss.State.Value())
+		haNodeSyncEnabled.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress).Set(syncEnabled)
+		haNodeFlips.WithLabelValues(P.nsInstance, string(ss.ID), ss.IPAddress).Set(ss.CurFlips.Value())
+		P.labelTTLs.setTTL(haNodeCollection, P.nsInstance, string(ss.ID), ss.IPAddress)
+	}
+}
+
+var haStatCollection = gaugeCollection{
+	haCurState,
+	haCurMasterState,
+	haTotalHeartbeatsRx,
+	haTotalHeartbeatsTx,
+	haTotalPropagationTimeouts,
+	haTotalSyncFailures,
+}
+
+var haNodeCollection = gaugeCollection{
+	haNodeRole,
+	haNodeSyncEnabled,
+	haNodeFlips,
+}
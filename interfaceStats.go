@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// InterfaceStats represents the data returned from the /stat/Interface Nitro API endpoint
+type InterfaceStats struct {
+	ID               string   `json:"id"`
+	State            CurState `json:"curintfstate"`
+	LinkState        CurState `json:"curlinkstate"`
+	LinkUptime       string   `json:"curlinkuptime"`
+	TotalRxBytes     string   `json:"totrxbytes"`
+	TotalTxBytes     string   `json:"tottxbytes"`
+	TotalRxPkts      string   `json:"totrxpkts"`
+	TotalTxPkts      string   `json:"tottxpkts"`
+	DroppedRxPkts    string   `json:"errdroppedrxpkts"`
+	DroppedTxPkts    string   `json:"errdroppedtxpkts"`
+	ErrorRxPkts      string   `json:"errpktrx"`
+	ErrorTxPkts      string   `json:"errpkttx"`
+	ActualSpeedMbits string   `json:"-"`
+}
+
+// InterfaceConfigs represents the data returned from the /config/Interface Nitro API endpoint
+type InterfaceConfigs struct {
+	ID          string `json:"id"`
+	ActualSpeed string `json:"actspeed"`
+}
+
+// NitroType implements the NitroData interface.
+func (s InterfaceStats) NitroType() string {
+	return interfaceSubsystem
+}
+
+func processInterfaceStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := interfaceSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			interfaces, err := GetInterfaceStats(P)
+			switch {
+			case err != nil:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS), zap.Error(err))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				P.logger.Debug("processing interface stats", zap.String("subSystem", thisSS), zap.Int("number of interfaces", len(interfaces)))
+				for _, intf := range interfaces {
+					req := newNitroDataReq(intf)
+					success := P.submit(req)
+					if !success {
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+				timeEnd := time.Now().UnixNano()
+				exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+// GetInterfaceStats retrieves stats for all Interfaces along with their configured link speed.
+func GetInterfaceStats(P *Pool) ([]InterfaceStats, error) {
+	var interfaces []InterfaceStats
+	var configs []InterfaceConfigs
+	b := submitAPITask(P, P.nitroStat(`Interface`))
+	if len(b) < 1 {
+		return interfaces, fmt.Errorf("error receiving data")
+	}
+	tmp := struct {
+		Target *[]InterfaceStats `json:"Interface"`
+	}{Target: &interfaces}
+	err := json.Unmarshal(b, &tmp)
+	if err != nil {
+		return interfaces, err
+	}
+	b = submitAPITask(P, P.nitroConfig(`Interface`))
+	if len(b) < 1 {
+		return interfaces, fmt.Errorf("error receiving data")
+	}
+	tmpCfg := struct {
+		Target *[]InterfaceConfigs `json:"Interface"`
+	}{Target: &configs}
+	err = json.Unmarshal(b, &tmpCfg)
+	if err != nil {
+		return interfaces, err
+	}
+	speeds := make(map[string]string, len(configs))
+	for _, c := range configs {
+		speeds[c.ID] = c.ActualSpeed
+	}
+	for i := range interfaces {
+		interfaces[i].ActualSpeedMbits = speeds[interfaces[i].ID]
+	}
+	return interfaces, nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/network/Interface/
+
+const interfaceSubsystem = `interface`
+
+var (
+	interfaceLabels = []string{netscalerInstance, `citrixadc_interface`}
+	interfaceState  = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "state",
+			Help:      "Current state of the interface. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		interfaceLabels,
+	)
+
+	interfaceLinkState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "link_state",
+			Help:      "Current link state of the interface. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		interfaceLabels,
+	)
+
+	interfaceLinkUptime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "link_uptime_seconds",
+			Help:      "Duration for which the link has been UP",
+		},
+		interfaceLabels,
+	)
+
+	interfaceSpeed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "speed_bytes",
+			Help:      "Actual negotiated speed of the interface in bytes per second",
+		},
+		interfaceLabels,
+	)
+
+	interfaceTotalRxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "received_bytes_total",
+			Help:      "Number of bytes received by the interface",
+		},
+		interfaceLabels,
+	)
+
+	interfaceTotalTxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "transmitted_bytes_total",
+			Help:      "Number of bytes transmitted by the interface",
+		},
+		interfaceLabels,
+	)
+
+	interfaceTotalRxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "received_packets_total",
+			Help:      "Number of packets received by the interface",
+		},
+		interfaceLabels,
+	)
+
+	interfaceTotalTxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "transmitted_packets_total",
+			Help:      "Number of packets transmitted by the interface",
+		},
+		interfaceLabels,
+	)
+
+	interfaceDroppedRxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "dropped_received_packets_total",
+			Help:      "Number of inbound packets dropped by the interface",
+		},
+		interfaceLabels,
+	)
+
+	interfaceDroppedTxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "dropped_transmitted_packets_total",
+			Help:      "Number of outbound packets dropped by the interface",
+		},
+		interfaceLabels,
+	)
+
+	interfaceErrorRxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "error_received_packets_total",
+			Help:      "Number of inbound packets with errors received by the interface",
+		},
+		interfaceLabels,
+	)
+
+	interfaceErrorTxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfaceSubsystem,
+			Name:      "error_transmitted_packets_total",
+			Help:      "Number of outbound packets with errors transmitted by the interface",
+		},
+		interfaceLabels,
+	)
+)
+
+func (P *Pool) promInterfaceStats(ss InterfaceStats) {
+	interfaceState.WithLabelValues(P.nsInstance, ss.ID).Set(ss.State.Value())
+	interfaceLinkState.WithLabelValues(P.nsInstance, ss.ID).Set(ss.LinkState.Value())
+	interfaceLinkUptime.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.LinkUptime))
+	// Value is in megabits. Convert to base unit of bytes.
+	interfaceSpeed.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.ActualSpeedMbits) * 1000 * 1000 / 8)
+	interfaceTotalRxBytes.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.TotalRxBytes))
+	interfaceTotalTxBytes.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.TotalTxBytes))
+	interfaceTotalRxPkts.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.TotalRxPkts))
+	interfaceTotalTxPkts.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.TotalTxPkts))
+	interfaceDroppedRxPkts.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.DroppedRxPkts))
+	interfaceDroppedTxPkts.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.DroppedTxPkts))
+	interfaceErrorRxPkts.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.ErrorRxPkts))
+	interfaceErrorTxPkts.WithLabelValues(P.nsInstance, ss.ID).Set(cast.ToFloat64(ss.ErrorTxPkts))
+	P.labelTTLs.setTTL(interfaceStatCollection, P.nsInstance, ss.ID)
+}
+
+var interfaceStatCollection = gaugeCollection{
+	interfaceState,
+	interfaceLinkState,
+	interfaceLinkUptime,
+	interfaceSpeed,
+	interfaceTotalRxBytes,
+	interfaceTotalTxBytes,
+	interfaceTotalRxPkts,
+	interfaceTotalTxPkts,
+	interfaceDroppedRxPkts,
+	interfaceDroppedTxPkts,
+	interfaceErrorRxPkts,
+	interfaceErrorTxPkts,
+}
@@ -18,8 +18,14 @@ func (r RawLBVServerConfigs) Len() int {
 
 // LBVServerConfigs represents the data returned from the /stat/service Nitro API endpoint
 type LBVServerConfigs struct {
-	Name                   string `json:"name"`
-	StateChangeTimeSeconds string `json:"statechangetimeseconds"`
+	Name                   string      `json:"name"`
+	StateChangeTimeSeconds string      `json:"statechangetimeseconds"`
+	IPAddress              string      `json:"ipv46"`
+	Port                   NitroNumber `json:"port"`
+	ServiceType            string      `json:"servicetype"`
+	LBMethod               string      `json:"lbmethod"`
+	PersistenceType        string      `json:"persistencetype"`
+	EffectiveState         CurState    `json:"effectivestate"`
 }
 
 // NitroType implements the NitroData interface.
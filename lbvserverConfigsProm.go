@@ -18,13 +18,44 @@ var (
 		},
 		lbvserverConfigLabels,
 	)
+
+	lbvserverEffectiveState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lbvserverConfigSubsystem,
+			Name:      "effective_state",
+			Help:      "Effective state of the vserver, taking into account the state of its backup vservers. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		lbvserverConfigLabels,
+	)
+)
+
+var (
+	lbvserverConfigInfoLabels = []string{netscalerInstance, `citrixadc_lb_name`, `citrixadc_lb_ip`, `citrixadc_lb_port`, `citrixadc_lb_type`, `citrixadc_lb_method`, `citrixadc_lb_persistence_type`}
+	lbvserverConfigInfo       = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lbvserverConfigSubsystem,
+			Name:      "info",
+			Help:      "A metric with a constant '1' value labeled by the vserver VIP, port, service type, lb method, and persistence type.",
+		},
+		lbvserverConfigInfoLabels,
+	)
 )
 
 func (P *Pool) promLBVServerConfigs(ss LBVServerConfigs) {
 	lbvserverLastStateChangeSecs.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.StateChangeTimeSeconds))
+	lbvserverEffectiveState.WithLabelValues(P.nsInstance, ss.Name).Set(ss.EffectiveState.Value())
 	P.labelTTLs.setTTL(lbvserverConfigCollection, P.nsInstance, ss.Name)
+	lbvserverConfigInfo.WithLabelValues(P.nsInstance, ss.Name, ss.IPAddress, string(ss.Port), ss.ServiceType, ss.LBMethod, ss.PersistenceType).Set(1)
+	P.labelTTLs.setCurrent(lbvserverConfigInfoCollection, ss.Name, P.nsInstance, ss.Name, ss.IPAddress, string(ss.Port), ss.ServiceType, ss.LBMethod, ss.PersistenceType)
 }
 
 var lbvserverConfigCollection = gaugeCollection{
 	lbvserverLastStateChangeSecs,
+	lbvserverEffectiveState,
+}
+
+var lbvserverConfigInfoCollection = gaugeCollection{
+	lbvserverConfigInfo,
 }
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawLSNStats is the payload as returned by the Nitro API.
+type RawLSNStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawLSNStats) Len() int {
+	return len(r)
+}
+
+// RawLSNGroupStats is the payload as returned by the Nitro API.
+type RawLSNGroupStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawLSNGroupStats) Len() int {
+	return len(r)
+}
+
+// LSNStats represents the data returned from the /stat/lsn Nitro API endpoint
+type LSNStats struct {
+	TCPCurrentSessions  string `json:"lsntcpcurrsessions"`
+	UDPCurrentSessions  string `json:"lsnudpcurrsessions"`
+	ICMPCurrentSessions string `json:"lsnicmpcurrsessions"`
+	TCPDroppedPkts      string `json:"lsntcpdrppkts"`
+	UDPDroppedPkts      string `json:"lsnudpdrppkts"`
+	ICMPDroppedPkts     string `json:"lsnicmpdrppkts"`
+	PortAllocFailures   string `json:"lsnportallocfailed"`
+}
+
+// LSNGroupStats represents the data returned from the /stat/lsngroup Nitro API endpoint
+type LSNGroupStats struct {
+	Name                string      `json:"groupname"`
+	TCPCurrentSessions  string      `json:"lsngrouptcpcurrsessions"`
+	UDPCurrentSessions  string      `json:"lsngroupudpcurrsessions"`
+	ICMPCurrentSessions string      `json:"lsngroupicmpcurrsessions"`
+	PortAllocFailures   string      `json:"lsngroupportallocfailed"`
+	PortUsagePct        NitroNumber `json:"lsngroupportusage"`
+}
+
+// NitroType implements the NitroData interface.
+func (s LSNStats) NitroType() string {
+	return lsnSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s LSNGroupStats) NitroType() string {
+	return lsnGroupSubsystem
+}
+
+func processLSNStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := lsnSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`lsn`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawLSNStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+func processLSNGroupStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := lsnGroupSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`lsngroup`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawLSNGroupStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/lsn/lsn/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/lsn/lsngroup/
+
+const (
+	lsnSubsystem      = `lsn`
+	lsnGroupSubsystem = `lsn_group`
+)
+
+var (
+	lsnLabels            = []string{netscalerInstance}
+	lsnPortAllocFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lsnSubsystem,
+			Name:      "port_allocation_failures_total",
+			Help:      "Total number of NAT port allocation failures",
+		},
+		lsnLabels,
+	)
+)
+
+var (
+	lsnProtocolLabels = []string{netscalerInstance, `citrixadc_lsn_protocol`}
+	lsnCurSessions    = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lsnSubsystem,
+			Name:      "current_sessions",
+			Help:      "Number of current LSN sessions by protocol",
+		},
+		lsnProtocolLabels,
+	)
+
+	lsnDroppedPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lsnSubsystem,
+			Name:      "dropped_packets_total",
+			Help:      "Total number of packets dropped by LSN by protocol",
+		},
+		lsnProtocolLabels,
+	)
+)
+
+var (
+	lsnGroupLabels            = []string{netscalerInstance, `citrixadc_lsn_group`}
+	lsnGroupPortAllocFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lsnSubsystem,
+			Name:      "group_port_allocation_failures_total",
+			Help:      "Total number of NAT port allocation failures for this LSN group",
+		},
+		lsnGroupLabels,
+	)
+
+	lsnGroupPortUsagePct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lsnSubsystem,
+			Name:      "group_port_usage_pct",
+			Help:      "Percentage of the NAT pool ports allocated to this LSN group currently in use",
+		},
+		lsnGroupLabels,
+	)
+)
+
+var (
+	lsnGroupProtocolLabels = []string{netscalerInstance, `citrixadc_lsn_group`, `citrixadc_lsn_protocol`}
+	lsnGroupCurSessions    = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lsnSubsystem,
+			Name:      "group_current_sessions",
+			Help:      "Number of current sessions for this LSN group by protocol",
+		},
+		lsnGroupProtocolLabels,
+	)
+)
+
+func (P *Pool) promLSNStats(ss LSNStats) {
+	lsnPortAllocFailures.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.PortAllocFailures))
+	P.labelTTLs.setTTL(lsnCollection, P.nsInstance)
+	for protocol, counts := range map[string][2]string{
+		`tcp`:  {ss.TCPCurrentSessions, ss.TCPDroppedPkts},
+		`udp`:  {ss.UDPCurrentSessions, ss.UDPDroppedPkts},
+		`icmp`: {ss.ICMPCurrentSessions, ss.ICMPDroppedPkts},
+	} {
+		lsnCurSessions.WithLabelValues(P.nsInstance, protocol).Set(cast.ToFloat64(counts[0]))
+		lsnDroppedPkts.WithLabelValues(P.nsInstance, protocol).Set(cast.ToFloat64(counts[1]))
+		P.labelTTLs.setTTL(lsnProtocolCollection, P.nsInstance, protocol)
+	}
+}
+
+func (P *Pool) promLSNGroupStats(ss LSNGroupStats) {
+	lsnGroupPortAllocFailures.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.PortAllocFailures))
+	lsnGroupPortUsagePct.WithLabelValues(P.nsInstance, ss.Name).Set(ss.PortUsagePct.Value())
+	P.labelTTLs.setTTL(lsnGroupCollection, P.nsInstance, ss.Name)
+	for protocol, sessions := range map[string]string{
+		`tcp`:  ss.TCPCurrentSessions,
+		`udp`:  ss.UDPCurrentSessions,
+		`icmp`: ss.ICMPCurrentSessions,
+	} {
+		lsnGroupCurSessions.WithLabelValues(P.nsInstance, ss.Name, protocol).Set(cast.ToFloat64(sessions))
+		P.labelTTLs.setTTL(lsnGroupProtocolCollection, P.nsInstance, ss.Name, protocol)
+	}
+}
+
+var lsnCollection = gaugeCollection{
+	lsnPortAllocFailures,
+}
+
+var lsnProtocolCollection = gaugeCollection{
+	lsnCurSessions,
+	lsnDroppedPkts,
+}
+
+var lsnGroupCollection = gaugeCollection{
+	lsnGroupPortAllocFailures,
+	lsnGroupPortUsagePct,
+}
+
+var lsnGroupProtocolCollection = gaugeCollection{
+	lsnGroupCurSessions,
+}
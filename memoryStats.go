@@ -0,0 +1,99 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawSystemStats is the payload as returned by the Nitro API.
+type RawSystemStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawSystemStats) Len() int {
+	return len(r)
+}
+
+// RawSystemMemoryStats is the payload as returned by the Nitro API.
+type RawSystemMemoryStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawSystemMemoryStats) Len() int {
+	return len(r)
+}
+
+// SystemStats represents the data returned from the /stat/system Nitro API endpoint
+type SystemStats struct {
+	MemUsagePct float64 `json:"memusagepcnt"`
+	MemUseInMB  string  `json:"memuseinmb"`
+}
+
+// SystemMemoryStats represents the data returned from the /stat/systemmemory Nitro API endpoint
+type SystemMemoryStats struct {
+	TotalAllocPct       float64 `json:"memtotallocpcnt"`
+	TotalAllocMB        string  `json:"memtotallocmb"`
+	TotalMB             string  `json:"memtotinmb"`
+	TotalAllocFailures  string  `json:"memerrallocfailed"`
+	SharedAllocPct      float64 `json:"shmemallocpcnt"`
+	SharedAllocMB       string  `json:"shmemallocinmb"`
+	SharedTotalMB       string  `json:"shmemtotinmb"`
+	SharedAllocFailures string  `json:"shmemerrallocfailed"`
+}
+
+// NitroType implements the NitroData interface.
+func (s SystemStats) NitroType() string {
+	return memorySubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s SystemMemoryStats) NitroType() string {
+	return memorySubsystem
+}
+
+func processMemoryStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := memorySubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`system`))
+			memData := submitAPITask(P, P.nitroStat(`systemmemory`))
+			switch {
+			case len(data) < 1 || len(memData) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				noErr := true
+				for _, raw := range []NitroRaw{RawSystemStats(data), RawSystemMemoryStats(memData)} {
+					req := newNitroRawReq(raw)
+					P.submit(req)
+					s := <-req.ResultChan()
+					if success, ok := s.(bool); !ok || !success {
+						noErr = false
+					}
+				}
+				switch {
+				case noErr:
+					go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+					timeEnd := time.Now().UnixNano()
+					exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				default:
+					exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/system/system/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/system/systemmemory/
+
+const memorySubsystem = `memory`
+
+var (
+	memoryLabels    = []string{netscalerInstance}
+	memoryUsedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: memorySubsystem,
+			Name:      "used_bytes",
+			Help:      "Main memory currently in use",
+		},
+		memoryLabels,
+	)
+
+	memoryUsagePct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: memorySubsystem,
+			Name:      "usage_pct",
+			Help:      "Percentage of memory utilization",
+		},
+		memoryLabels,
+	)
+)
+
+var (
+	memoryPoolLabels     = []string{netscalerInstance, `citrixadc_memory_pool`}
+	memoryPoolAllocBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: memorySubsystem,
+			Name:      "pool_allocated_bytes",
+			Help:      "Memory currently allocated from the pool",
+		},
+		memoryPoolLabels,
+	)
+
+	memoryPoolAllocPct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: memorySubsystem,
+			Name:      "pool_allocated_pct",
+			Help:      "Percentage of the pool currently allocated",
+		},
+		memoryPoolLabels,
+	)
+
+	memoryPoolTotalBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: memorySubsystem,
+			Name:      "pool_size_bytes",
+			Help:      "Total size of the pool",
+		},
+		memoryPoolLabels,
+	)
+
+	memoryPoolFreeBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: memorySubsystem,
+			Name:      "pool_free_bytes",
+			Help:      "Memory still available for allocation from the pool",
+		},
+		memoryPoolLabels,
+	)
+
+	memoryPoolAllocFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: memorySubsystem,
+			Name:      "pool_allocation_failures_total",
+			Help:      "Total number of failed memory allocations from the pool",
+		},
+		memoryPoolLabels,
+	)
+)
+
+func (P *Pool) promSystemStats(ss SystemStats) {
+	memoryUsedBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.MemUseInMB) * 1024 * 1024)
+	memoryUsagePct.WithLabelValues(P.nsInstance).Set(ss.MemUsagePct)
+	P.labelTTLs.setTTL(memoryCollection, P.nsInstance)
+}
+
+func (P *Pool) promSystemMemoryStats(ss SystemMemoryStats) {
+	pools := []struct {
+		name                      string
+		pct                       float64
+		alloc, total, allocFailed string
+	}{
+		{`total`, ss.TotalAllocPct, ss.TotalAllocMB, ss.TotalMB, ss.TotalAllocFailures},
+		{`shared`, ss.SharedAllocPct, ss.SharedAllocMB, ss.SharedTotalMB, ss.SharedAllocFailures},
+	}
+	for _, p := range pools {
+		alloc := cast.ToFloat64(p.alloc) * 1024 * 1024
+		total := cast.ToFloat64(p.total) * 1024 * 1024
+		memoryPoolAllocBytes.WithLabelValues(P.nsInstance, p.name).Set(alloc)
+		memoryPoolAllocPct.WithLabelValues(P.nsInstance, p.name).Set(p.pct)
+		memoryPoolTotalBytes.WithLabelValues(P.nsInstance, p.name).Set(total)
+		memoryPoolFreeBytes.WithLabelValues(P.nsInstance, p.name).Set(total - alloc)
+		memoryPoolAllocFailures.WithLabelValues(P.nsInstance, p.name).Set(cast.ToFloat64(p.allocFailed))
+		P.labelTTLs.setTTL(memoryPoolCollection, P.nsInstance, p.name)
+	}
+}
+
+var memoryCollection = gaugeCollection{
+	memoryUsedBytes,
+	memoryUsagePct,
+}
+
+var memoryPoolCollection = gaugeCollection{
+	memoryPoolAllocBytes,
+	memoryPoolAllocPct,
+	memoryPoolTotalBytes,
+	memoryPoolFreeBytes,
+	memoryPoolAllocFailures,
+}
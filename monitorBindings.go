@@ -0,0 +1,140 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawServiceMonitorBindings is the payload as returned by the Nitro API.
+type RawServiceMonitorBindings []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawServiceMonitorBindings) Len() int {
+	return len(r)
+}
+
+// ServiceMonitorBindings represents the data returned from the /config/service_lbmonitor_binding Nitro API endpoint
+type ServiceMonitorBindings struct {
+	Name                string      `json:"name"`
+	MonitorName         string      `json:"monitor_name"`
+	MonitorState        CurState    `json:"monitor_state"`
+	TotalProbes         NitroNumber `json:"totalprobes"`
+	TotalFailedProbes   NitroNumber `json:"totalfailedprobes"`
+	CurrentFailedProbes NitroNumber `json:"failedprobes"`
+	ResponseTime        NitroNumber `json:"responsetime"`
+}
+
+// RawServiceGroupMonitorBindings is the payload as returned by the Nitro API.
+type RawServiceGroupMonitorBindings []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawServiceGroupMonitorBindings) Len() int {
+	return len(r)
+}
+
+// ServiceGroupMonitorBindings represents the data returned from the /config/servicegroup_lbmonitor_binding Nitro API endpoint
+type ServiceGroupMonitorBindings struct {
+	Name                string      `json:"servicegroupname"`
+	MonitorName         string      `json:"monitor_name"`
+	MonitorState        CurState    `json:"monitor_state"`
+	TotalProbes         NitroNumber `json:"totalprobes"`
+	TotalFailedProbes   NitroNumber `json:"totalfailedprobes"`
+	CurrentFailedProbes NitroNumber `json:"failedprobes"`
+	ResponseTime        NitroNumber `json:"responsetime"`
+}
+
+// NitroType implements the NitroData interface.
+func (s ServiceMonitorBindings) NitroType() string {
+	return monitorSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s ServiceGroupMonitorBindings) NitroType() string {
+	return monitorServiceGroupSubsystem
+}
+
+func processServiceMonitorBindings(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := monitorSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroConfig(`service_lbmonitor_binding?bulkbindings=yes`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawServiceMonitorBindings(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+func processServiceGroupMonitorBindings(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := monitorServiceGroupSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroConfig(`servicegroup_lbmonitor_binding?bulkbindings=yes`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawServiceGroupMonitorBindings(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/basic/service_lbmonitor_binding/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/basic/servicegroup_lbmonitor_binding/
+
+const (
+	monitorSubsystem             = `monitor`
+	monitorServiceGroupSubsystem = `monitor_servicegroup`
+)
+
+var (
+	monitorLabels = []string{netscalerInstance, `citrixadc_service_name`, `citrixadc_servicegroup_name`, `citrixadc_monitor_name`}
+	monitorState  = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorSubsystem,
+			Name:      "state",
+			Help:      "Current state of the monitor probing the service or servicegroup. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		monitorLabels,
+	)
+
+	monitorProbes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorSubsystem,
+			Name:      "probes_total",
+			Help:      "Total number of probes sent by the monitor",
+		},
+		monitorLabels,
+	)
+
+	monitorFailedProbes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorSubsystem,
+			Name:      "failed_probes_total",
+			Help:      "Total number of failed probes",
+		},
+		monitorLabels,
+	)
+
+	monitorCurFailedProbes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorSubsystem,
+			Name:      "current_failed_probes",
+			Help:      "Number of consecutive failed probes",
+		},
+		monitorLabels,
+	)
+
+	monitorResponseTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorSubsystem,
+			Name:      "response_time_seconds",
+			Help:      "Response time of the last probe",
+		},
+		monitorLabels,
+	)
+)
+
+func (P *Pool) promServiceMonitorBindings(ss ServiceMonitorBindings) {
+	P.promMonitorBinding(ss.Name, "", ss.MonitorName, ss.MonitorState, ss.TotalProbes, ss.TotalFailedProbes, ss.CurrentFailedProbes, ss.ResponseTime)
+}
+
+func (P *Pool) promServiceGroupMonitorBindings(ss ServiceGroupMonitorBindings) {
+	P.promMonitorBinding("", ss.Name, ss.MonitorName, ss.MonitorState, ss.TotalProbes, ss.TotalFailedProbes, ss.CurrentFailedProbes, ss.ResponseTime)
+}
+
+func (P *Pool) promMonitorBinding(service, servicegroup, monitor string, state CurState, probes, failedProbes, curFailedProbes, responseTime NitroNumber) {
+	monitorState.WithLabelValues(P.nsInstance, service, servicegroup, monitor).Set(state.Value())
+	monitorProbes.WithLabelValues(P.nsInstance, service, servicegroup, monitor).Set(probes.Value())
+	monitorFailedProbes.WithLabelValues(P.nsInstance, service, servicegroup, monitor).Set(failedProbes.Value())
+	monitorCurFailedProbes.WithLabelValues(P.nsInstance, service, servicegroup, monitor).Set(curFailedProbes.Value())
+	// Value is in milliseconds. Convert to base unit of seconds.
+	monitorResponseTime.WithLabelValues(P.nsInstance, service, servicegroup, monitor).Set(responseTime.Value() * 0.001)
+	P.labelTTLs.setTTL(monitorCollection, P.nsInstance, service, servicegroup, monitor)
+}
+
+var monitorCollection = gaugeCollection{
+	monitorState,
+	monitorProbes,
+	monitorFailedProbes,
+	monitorCurFailedProbes,
+	monitorResponseTime,
+}
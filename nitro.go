@@ -1,7 +1,10 @@
 package main
 
 import (
+	"strings"
 	"sync"
+
+	"github.com/spf13/cast"
 )
 
 const (
@@ -57,6 +60,23 @@ func (r RawMultiData) Len() int {
 	return len(r)
 }
 
+// NitroResource is a Nitro API endpoint not defined within the netscaler package.
+// The netscaler package uses its value as-is, so it must contain the full URL.
+type NitroResource string
+
+// String implements the netscaler.NitroType interface.
+func (n NitroResource) String() string {
+	return string(n)
+}
+
+func (p *Pool) nitroStat(resource string) NitroResource {
+	return NitroResource(strings.Trim(p.lbserver.URL, " /") + `/nitro/v1/stat/` + resource)
+}
+
+func (p *Pool) nitroConfig(resource string) NitroResource {
+	return NitroResource(strings.Trim(p.lbserver.URL, " /") + `/nitro/v1/config/` + resource)
+}
+
 type metricHandleFunc func(*Pool, *sync.WaitGroup)
 
 func defaultMetricHandleFunc(P *Pool, wg *sync.WaitGroup) {
@@ -64,13 +84,46 @@ func defaultMetricHandleFunc(P *Pool, wg *sync.WaitGroup) {
 }
 
 var metricsMap = map[string]metricHandleFunc{
-	servicesSubsystem:        processSvcStats,
-	nsSubsystem:              processNSStats,
-	sslSubsystem:             processSSLStats,
-	lbvserverSubsystem:       processLBVServerStats,
-	lbvserviceSubsystem:      processLBVServiceStats,
-	gslbVServerSubsystem:     processGSLBVServerStats,
-	lbvserverConfigSubsystem: processLBVServerConfigs,
+	servicesSubsystem:            processSvcStats,
+	nsSubsystem:                  processNSStats,
+	nsCPUSubsystem:               processSystemCPUStats,
+	sslSubsystem:                 processSSLStats,
+	sslVServerSubsystem:          processSSLVServerStats,
+	lbvserverSubsystem:           processLBVServerStats,
+	lbvserviceSubsystem:          processLBVServiceStats,
+	gslbVServerSubsystem:         processGSLBVServerStats,
+	lbvserverConfigSubsystem:     processLBVServerConfigs,
+	csvserverSubsystem:           processCSVServerStats,
+	csvserverPolicySubsystem:     processCSVServerPolicies,
+	servicegroupSubsystem:        processServiceGroupStats,
+	interfaceSubsystem:           processInterfaceStats,
+	haSubsystem:                  processHAStats,
+	clusterSubsystem:             processClusterStats,
+	sslCertKeySubsystem:          processSSLCertKeyConfigs,
+	protocolTCPSubsystem:         processProtocolTCPStats,
+	protocolHTTPSubsystem:        processProtocolHTTPStats,
+	protocolIPSubsystem:          processProtocolIPStats,
+	memorySubsystem:              processMemoryStats,
+	aaaSubsystem:                 processAAAStats,
+	vpnSubsystem:                 processVPNVServerStats,
+	cacheSubsystem:               processCacheStats,
+	cmpSubsystem:                 processCMPStats,
+	appfwSubsystem:               processAppFWStats,
+	policySubsystem:              processPolicyStats,
+	dnsSubsystem:                 processDNSStats,
+	gslbDomainSubsystem:          processGSLBDomainStats,
+	gslbSiteSubsystem:            processGSLBSiteStats,
+	serverSubsystem:              processServerConfigs,
+	monitorSubsystem:             processServiceMonitorBindings,
+	monitorServiceGroupSubsystem: processServiceGroupMonitorBindings,
+	capacitySubsystem:            processCapacityStats,
+	vlanSubsystem:                processVLANStats,
+	bridgeSubsystem:              processBridgeStats,
+	lsnSubsystem:                 processLSNStats,
+	lsnGroupSubsystem:            processLSNGroupStats,
+	nslimitSubsystem:             processNSLimitIdentifierStats,
+	persistenceSubsystem:         processPersistenceStats,
+	nsConfigSubsystem:            processNSConfigStats,
 }
 
 // CurState is the current state as returned by the Nitro API.
@@ -89,3 +142,17 @@ func (c CurState) Value() float64 {
 		return 3.0
 	}
 }
+
+// NitroNumber is a numeric value returned by the Nitro API which may be encoded as either a JSON string or number.
+type NitroNumber string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NitroNumber) UnmarshalJSON(b []byte) error {
+	*n = NitroNumber(strings.Trim(string(b), `"`))
+	return nil
+}
+
+// Value returns the float64 value of the NitroNumber.
+func (n NitroNumber) Value() float64 {
+	return cast.ToFloat64(string(n))
+}
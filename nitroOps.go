@@ -19,6 +19,12 @@ type SvcBind struct {
 	colTime     int64
 }
 
+// SvcGroupBind represents a service group bind configuration.
+type SvcGroupBind struct {
+	Name             string `json:"name"`
+	ServiceGroupName string `json:"servicegroupname"`
+}
+
 // NSHardware represents NSHardware data returned from the Nitro API
 type NSHardware struct {
 	HWDescription   string `json:"hwdescription"`
@@ -51,6 +57,23 @@ func GetSvcBindings(client *netscaler.NitroClient) ([]SvcBind, error) {
 	return target, nil
 }
 
+// GetSvcGroupBindings take a Pool and returns Service Group Bindings.
+func GetSvcGroupBindings(P *Pool) ([]SvcGroupBind, error) {
+	var target []SvcGroupBind
+	b, err := P.client.GetAll(P.nitroConfig(`lbvserver_servicegroup_binding?bulkbindings=yes`))
+	if err != nil {
+		return target, err
+	}
+	tmp := struct {
+		Target *[]SvcGroupBind `json:"lbvserver_servicegroup_binding"`
+	}{Target: &target}
+	err = json.Unmarshal(b, &tmp)
+	if err != nil {
+		return target, err
+	}
+	return target, nil
+}
+
 // GetNSInfo returns the model, verion and manufacture year for the Netscaler Appliance.
 func GetNSInfo(client *netscaler.NitroClient) (model, version string, year int, err error) {
 	version, err = GetNSVersion(client)
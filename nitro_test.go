@@ -0,0 +1,39 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNitroNumber(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		str   NitroNumber
+		value float64
+	}{
+		{"string", `{"n":"42"}`, "42", 42},
+		{"number", `{"n":42}`, "42", 42},
+		{"decimal string", `{"n":"12.5"}`, "12.5", 12.5},
+		{"decimal number", `{"n":12.5}`, "12.5", 12.5},
+		{"empty string", `{"n":""}`, "", 0},
+		{"not a number", `{"n":"N/A"}`, "N/A", 0},
+		{"missing", `{}`, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v struct {
+				N NitroNumber `json:"n"`
+			}
+			if err := json.Unmarshal([]byte(tt.input), &v); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v.N != tt.str {
+				t.Errorf("got %q, want %q", v.N, tt.str)
+			}
+			if got := v.N.Value(); got != tt.value {
+				t.Errorf("Value() = %v, want %v", got, tt.value)
+			}
+		})
+	}
+}
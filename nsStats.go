@@ -34,11 +34,30 @@ type NSStats struct {
 	TCPCurrentServerConnectionsEstablished string  `json:"tcpcurserverconnestablished"`
 }
 
+// RawSystemCPUStats is the payload as returned by the Nitro API.
+type RawSystemCPUStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawSystemCPUStats) Len() int {
+	return len(r)
+}
+
+// SystemCPUStats represents the data returned from the /stat/systemcpu Nitro API endpoint
+type SystemCPUStats struct {
+	ID       NitroNumber `json:"id"`
+	UsagePct NitroNumber `json:"percpuuse"`
+}
+
 // NitroType implements the NitroData interface.
 func (s NSStats) NitroType() string {
 	return nsSubsystem
 }
 
+// NitroType implements the NitroData interface.
+func (s SystemCPUStats) NitroType() string {
+	return nsCPUSubsystem
+}
+
 func processNSStats(P *Pool, wg *sync.WaitGroup) {
 	if wg != nil {
 		defer wg.Done()
@@ -80,3 +99,45 @@ func processNSStats(P *Pool, wg *sync.WaitGroup) {
 		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
 	}
 }
+
+func processSystemCPUStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := nsCPUSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`systemcpu`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawSystemCPUStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -6,8 +6,12 @@ import (
 )
 
 // https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/ns/ns/ns/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/system/systemcpu/
 
-const nsSubsystem = `ns`
+const (
+	nsSubsystem    = `ns`
+	nsCPUSubsystem = `ns_cpu`
+)
 
 var (
 	nsLabels      = []string{netscalerInstance}
@@ -152,6 +156,19 @@ var (
 	)
 )
 
+var (
+	nsCPUCoreLabels   = []string{netscalerInstance, `core`}
+	nsCPUCoreUsagePct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nsSubsystem,
+			Name:      "cpu_core_usage_pct",
+			Help:      "CPU utilization percentage of an individual packet engine core",
+		},
+		nsCPUCoreLabels,
+	)
+)
+
 func (P *Pool) promNSStats(ss NSStats) {
 	nsCPUUsagePct.WithLabelValues(P.nsInstance).Set(ss.CPUUsagePct)
 	nsMemUsagePct.WithLabelValues(P.nsInstance).Set(ss.MemUsagePct)
@@ -170,6 +187,11 @@ func (P *Pool) promNSStats(ss NSStats) {
 	P.labelTTLs.setTTL(nsStatCollection, P.nsInstance)
 }
 
+func (P *Pool) promSystemCPUStats(ss SystemCPUStats) {
+	nsCPUCoreUsagePct.WithLabelValues(P.nsInstance, string(ss.ID)).Set(ss.UsagePct.Value())
+	P.labelTTLs.setTTL(nsCPUCoreCollection, P.nsInstance, string(ss.ID))
+}
+
 var nsStatCollection = gaugeCollection{
 	nsCPUUsagePct,
 	nsMemUsagePct,
@@ -186,3 +208,7 @@ var nsStatCollection = gaugeCollection{
 	nsTCPCurServerConns,
 	nsTCPCurServerConnsEst,
 }
+
+var nsCPUCoreCollection = gaugeCollection{
+	nsCPUCoreUsagePct,
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+	"go.uber.org/zap"
+)
+
+// NSConfigStats represents the data returned from the /config/nsconfig Nitro API endpoint along with the boot time from /stat/system.
+type NSConfigStats struct {
+	LastConfigChangedTime string      `json:"lastconfigchangedtime"`
+	LastConfigSaveTime    string      `json:"lastconfigsavetime"`
+	SystemTime            NitroNumber `json:"systemtime"`
+	CurrentSystemTime     string      `json:"currentsytemtime"`
+	ConfigChanged         interface{} `json:"configchanged"`
+	StartTime             string      `json:"-"`
+}
+
+// NitroType implements the NitroData interface.
+func (s NSConfigStats) NitroType() string {
+	return nsConfigSubsystem
+}
+
+// Unsaved returns true when the running configuration differs from the saved configuration.
+func (s NSConfigStats) Unsaved() bool {
+	return cast.ToBool(s.ConfigChanged)
+}
+
+// localOffset returns the difference between the appliance local time and UTC.
+// Nitro reports the timestamps below in appliance local time while systemtime is in epoch seconds.
+func (s NSConfigStats) localOffset() time.Duration {
+	local, err := parseNitroTime(s.CurrentSystemTime)
+	if err != nil || s.SystemTime.Value() == 0 {
+		return 0
+	}
+	return local.Sub(time.Unix(int64(s.SystemTime.Value()), 0)).Round(time.Minute)
+}
+
+// EpochSeconds converts a Nitro local timestamp into seconds since the epoch.
+func (s NSConfigStats) EpochSeconds(nitroTime string) (float64, error) {
+	t, err := parseNitroTime(nitroTime)
+	if err != nil {
+		return 0, err
+	}
+	return float64(t.Add(-s.localOffset()).Unix()), nil
+}
+
+// parseNitroTime parses timestamps returned by Nitro, eg. "Thu Feb  6 10:13:38 2020", as UTC.
+func parseNitroTime(nitroTime string) (time.Time, error) {
+	return time.Parse(time.ANSIC, strings.TrimSpace(nitroTime))
+}
+
+func processNSConfigStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := nsConfigSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			nsConfig, err := GetNSConfigStats(P)
+			switch {
+			case err != nil:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS), zap.Error(err))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroDataReq(nsConfig)
+				success := P.submit(req)
+				if !success {
+					exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				}
+				go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+				timeEnd := time.Now().UnixNano()
+				exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+// GetNSConfigStats retrieves the config change and save times along with the boot time of the appliance.
+func GetNSConfigStats(P *Pool) (NSConfigStats, error) {
+	var nsConfig NSConfigStats
+	b := submitAPITask(P, P.nitroConfig(`nsconfig`))
+	if len(b) < 1 {
+		return nsConfig, fmt.Errorf("error receiving data")
+	}
+	tmp := struct {
+		Target *NSConfigStats `json:"nsconfig"`
+	}{Target: &nsConfig}
+	err := json.Unmarshal(b, &tmp)
+	if err != nil {
+		return nsConfig, err
+	}
+	b = submitAPITask(P, P.nitroStat(`system`))
+	if len(b) < 1 {
+		return nsConfig, fmt.Errorf("error receiving data")
+	}
+	var system struct {
+		StartTime string `json:"starttime"`
+	}
+	tmpSys := struct {
+		Target interface{} `json:"system"`
+	}{Target: &system}
+	err = json.Unmarshal(b, &tmpSys)
+	if err != nil {
+		return nsConfig, err
+	}
+	nsConfig.StartTime = system.StartTime
+	return nsConfig, nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/ns/nsconfig/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/system/system/
+
+const nsConfigSubsystem = `nsconfig`
+
+var (
+	nsBootTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nsSubsystem,
+			Name:      "boot_time_seconds",
+			Help:      "Time the appliance was last started, in seconds since the epoch",
+		},
+		nsLabels,
+	)
+
+	nsLastConfigChangeTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nsSubsystem,
+			Name:      "last_config_change_time_seconds",
+			Help:      "Time the running configuration was last changed, in seconds since the epoch",
+		},
+		nsLabels,
+	)
+
+	nsLastConfigSaveTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nsSubsystem,
+			Name:      "last_config_save_time_seconds",
+			Help:      "Time the running configuration was last saved, in seconds since the epoch",
+		},
+		nsLabels,
+	)
+
+	nsConfigUnsaved = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nsSubsystem,
+			Name:      "config_unsaved",
+			Help:      "Whether the running configuration differs from the saved configuration. 0 = SAVED, 1 = UNSAVED",
+		},
+		nsLabels,
+	)
+)
+
+func (P *Pool) promNSConfigStats(ss NSConfigStats) {
+	for gauge, nitroTime := range map[*prometheus.GaugeVec]string{
+		nsBootTime:             ss.StartTime,
+		nsLastConfigChangeTime: ss.LastConfigChangedTime,
+		nsLastConfigSaveTime:   ss.LastConfigSaveTime,
+	} {
+		epoch, err := ss.EpochSeconds(nitroTime)
+		if err != nil {
+			P.logger.Debug("unable to parse nsconfig timestamp", zap.String("timestamp", nitroTime), zap.Error(err))
+			continue
+		}
+		gauge.WithLabelValues(P.nsInstance).Set(epoch)
+		P.labelTTLs.setTTL(gaugeCollection{gauge}, P.nsInstance)
+	}
+	var unsaved float64
+	if ss.Unsaved() {
+		unsaved = 1
+	}
+	nsConfigUnsaved.WithLabelValues(P.nsInstance).Set(unsaved)
+	P.labelTTLs.setTTL(nsConfigCollection, P.nsInstance)
+}
+
+var nsConfigCollection = gaugeCollection{
+	nsConfigUnsaved,
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestParseNitroTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"single digit day", "Thu Feb  6 10:13:38 2020", time.Date(2020, 2, 6, 10, 13, 38, 0, time.UTC), false},
+		{"double digit day", "Mon Nov 16 22:01:05 2020", time.Date(2020, 11, 16, 22, 1, 5, 0, time.UTC), false},
+		{"surrounding whitespace", " Thu Feb  6 10:13:38 2020\n", time.Date(2020, 2, 6, 10, 13, 38, 0, time.UTC), false},
+		{"empty", "", time.Time{}, true},
+		{"wrong layout", "2020-02-06T10:13:38Z", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseNitroTime(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNSConfigStatsLocalOffset(t *testing.T) {
+	utc := time.Date(2020, 2, 6, 15, 13, 38, 0, time.UTC)
+	epoch := NitroNumber(strconv.FormatInt(utc.Unix(), 10))
+	tests := []struct {
+		name   string
+		stats  NSConfigStats
+		offset time.Duration
+	}{
+		{"utc", NSConfigStats{SystemTime: epoch, CurrentSystemTime: "Thu Feb  6 15:13:38 2020"}, 0},
+		{"behind utc", NSConfigStats{SystemTime: epoch, CurrentSystemTime: "Thu Feb  6 10:13:38 2020"}, -5 * time.Hour},
+		{"ahead of utc", NSConfigStats{SystemTime: epoch, CurrentSystemTime: "Thu Feb  6 20:43:38 2020"}, 5*time.Hour + 30*time.Minute},
+		{"rounds seconds of drift", NSConfigStats{SystemTime: epoch, CurrentSystemTime: "Thu Feb  6 10:13:40 2020"}, -5 * time.Hour},
+		{"missing system time", NSConfigStats{CurrentSystemTime: "Thu Feb  6 10:13:38 2020"}, 0},
+		{"unparsable current time", NSConfigStats{SystemTime: epoch, CurrentSystemTime: "unknown"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stats.localOffset(); got != tt.offset {
+				t.Errorf("localOffset() = %v, want %v", got, tt.offset)
+			}
+		})
+	}
+}
+
+func TestNSConfigStatsEpochSeconds(t *testing.T) {
+	utc := time.Date(2020, 2, 6, 15, 13, 38, 0, time.UTC)
+	ss := NSConfigStats{
+		SystemTime:        NitroNumber(strconv.FormatInt(utc.Unix(), 10)),
+		CurrentSystemTime: "Thu Feb  6 10:13:38 2020",
+	}
+	tests := []struct {
+		name    string
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{"current time", "Thu Feb  6 10:13:38 2020", float64(utc.Unix()), false},
+		{"earlier time", "Wed Feb  5 09:00:00 2020", float64(time.Date(2020, 2, 5, 14, 0, 0, 0, time.UTC).Unix()), false},
+		{"unparsable", "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ss.EpochSeconds(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
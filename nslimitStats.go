@@ -0,0 +1,72 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawNSLimitIdentifierStats is the payload as returned by the Nitro API.
+type RawNSLimitIdentifierStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawNSLimitIdentifierStats) Len() int {
+	return len(r)
+}
+
+// NSLimitIdentifierStats represents the data returned from the /stat/nslimitidentifier Nitro API endpoint
+type NSLimitIdentifierStats struct {
+	Name            string      `json:"name"`
+	Hits            string      `json:"ratelimitobjhits"`
+	Drops           string      `json:"ratelimitobjdrops"`
+	SessionHits     string      `json:"ratelimitsessionobjhits"`
+	CurrentSessions NitroNumber `json:"ratelimitcurrentsessions"`
+}
+
+// NitroType implements the NitroData interface.
+func (s NSLimitIdentifierStats) NitroType() string {
+	return nslimitSubsystem
+}
+
+func processNSLimitIdentifierStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := nslimitSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`nslimitidentifier`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawNSLimitIdentifierStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
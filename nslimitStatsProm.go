@@ -0,0 +1,68 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/ns/nslimitidentifier/
+
+const nslimitSubsystem = `nslimit`
+
+var (
+	nslimitLabels = []string{netscalerInstance, `citrixadc_limit_identifier`}
+	nslimitHits   = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nslimitSubsystem,
+			Name:      "hits_total",
+			Help:      "Total number of times the rule and selector of the limit identifier matched",
+		},
+		nslimitLabels,
+	)
+
+	nslimitDrops = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nslimitSubsystem,
+			Name:      "drops_total",
+			Help:      "Total number of requests dropped or throttled by the limit identifier",
+		},
+		nslimitLabels,
+	)
+
+	nslimitSessionHits = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nslimitSubsystem,
+			Name:      "session_hits_total",
+			Help:      "Total number of hits on the sessions tracked by the limit identifier",
+		},
+		nslimitLabels,
+	)
+
+	nslimitCurSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: nslimitSubsystem,
+			Name:      "current_sessions",
+			Help:      "Number of sessions currently tracked by the limit identifier",
+		},
+		nslimitLabels,
+	)
+)
+
+func (P *Pool) promNSLimitIdentifierStats(ss NSLimitIdentifierStats) {
+	nslimitHits.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.Hits))
+	nslimitDrops.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.Drops))
+	nslimitSessionHits.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.SessionHits))
+	nslimitCurSessions.WithLabelValues(P.nsInstance, ss.Name).Set(ss.CurrentSessions.Value())
+	P.labelTTLs.setTTL(nslimitCollection, P.nsInstance, ss.Name)
+}
+
+var nslimitCollection = gaugeCollection{
+	nslimitHits,
+	nslimitDrops,
+	nslimitSessionHits,
+	nslimitCurSessions,
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PersistenceStats represents the persistent session count for a lbvserver as returned from the /config/lbpersistentsessions Nitro API endpoint
+type PersistenceStats struct {
+	Name            string
+	PersistenceType string
+	Sessions        float64
+}
+
+// NitroType implements the NitroData interface.
+func (s PersistenceStats) NitroType() string {
+	return persistenceSubsystem
+}
+
+func processPersistenceStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := persistenceSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			sessions, err := GetPersistenceStats(P)
+			switch {
+			case err != nil:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS), zap.Error(err))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				P.logger.Debug("processing persistence stats", zap.String("subSystem", thisSS), zap.Int("number of lbvservers", len(sessions)))
+				for _, s := range sessions {
+					req := newNitroDataReq(s)
+					success := P.submit(req)
+					if !success {
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+				timeEnd := time.Now().UnixNano()
+				exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+// GetPersistenceStats retrieves the persistent session count for every lbvserver configured with a persistence type.
+func GetPersistenceStats(P *Pool) ([]PersistenceStats, error) {
+	var sessions []PersistenceStats
+	var configs []struct {
+		Name            string `json:"name"`
+		PersistenceType string `json:"persistencetype"`
+	}
+	b := submitAPITask(P, P.nitroConfig(`lbvserver?attrs=name,persistencetype`))
+	if len(b) < 1 {
+		return sessions, fmt.Errorf("error receiving data")
+	}
+	tmp := struct {
+		Target interface{} `json:"lbvserver"`
+	}{Target: &configs}
+	err := json.Unmarshal(b, &tmp)
+	if err != nil {
+		return sessions, err
+	}
+	for _, c := range configs {
+		if c.PersistenceType == "" || c.PersistenceType == `NONE` {
+			continue
+		}
+		sessions = append(sessions, PersistenceStats{
+			Name:            c.Name,
+			PersistenceType: c.PersistenceType,
+		})
+	}
+	countChan := make(chan PersistenceStats, len(sessions)+1)
+	errChan := make(chan bool, len(sessions)+1)
+	var controlSize float64 = 10
+	control := int(math.Round((float64(len(sessions)) / controlSize) + 0.6))
+	var count int
+	for count < len(sessions) {
+		begin := count
+		end := count + control
+		if end > len(sessions) {
+			end = len(sessions)
+		}
+		groups := sessions[begin:end]
+		count = end
+		go func(groups []PersistenceStats) {
+			for _, s := range groups {
+				b := submitAPITask(P, P.nitroConfig(`lbpersistentsessions?count=yes&args=vserver:`+url.QueryEscape(s.Name)))
+				n, err := parsePersistentSessionCount(b)
+				switch {
+				case err == nil:
+					s.Sessions = n
+					countChan <- s
+				default:
+					errChan <- false
+				}
+			}
+		}(groups)
+	}
+	var counted []PersistenceStats
+	for i := 0; i < len(sessions); i++ {
+		select {
+		case <-errChan:
+			exporterMissedMetrics.WithLabelValues(P.nsInstance, persistenceSubsystem).Inc()
+		case s := <-countChan:
+			counted = append(counted, s)
+		}
+	}
+	close(errChan)
+	close(countChan)
+	return counted, nil
+}
+
+// parsePersistentSessionCount returns the session count from a /config/lbpersistentsessions?count=yes response.
+func parsePersistentSessionCount(b []byte) (float64, error) {
+	if len(b) < 1 {
+		return 0, fmt.Errorf("error receiving data")
+	}
+	var counts []struct {
+		Count NitroNumber `json:"__count"`
+	}
+	tmp := struct {
+		Target interface{} `json:"lbpersistentsessions"`
+	}{Target: &counts}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return 0, err
+	}
+	if len(counts) < 1 {
+		return 0, nil
+	}
+	return counts[0].Count.Value(), nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/load-balancing/lbpersistentsessions/
+
+const persistenceSubsystem = `persistence`
+
+var (
+	lbvserverPersistenceLabels  = []string{netscalerInstance, `citrixadc_lb_name`, `citrixadc_lb_persistence_type`}
+	lbvserverPersistentSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: lbvserverSubsystem,
+			Name:      "persistent_sessions",
+			Help:      "Number of persistent sessions currently held by the vserver",
+		},
+		lbvserverPersistenceLabels,
+	)
+)
+
+func (P *Pool) promPersistenceStats(ss PersistenceStats) {
+	lbvserverPersistentSessions.WithLabelValues(P.nsInstance, ss.Name, ss.PersistenceType).Set(ss.Sessions)
+	P.labelTTLs.setTTL(persistenceCollection, P.nsInstance, ss.Name, ss.PersistenceType)
+}
+
+var persistenceCollection = gaugeCollection{
+	lbvserverPersistentSessions,
+}
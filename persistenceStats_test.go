@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestParsePersistentSessionCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{"string count", `{"errorcode":0,"lbpersistentsessions":[{"__count":"17"}]}`, 17, false},
+		{"number count", `{"errorcode":0,"lbpersistentsessions":[{"__count":17}]}`, 17, false},
+		{"zero count", `{"errorcode":0,"lbpersistentsessions":[{"__count":"0"}]}`, 0, false},
+		{"no sessions", `{"errorcode":0}`, 0, false},
+		{"empty response", ``, 0, true},
+		{"invalid json", `{"lbpersistentsessions":`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePersistentSessionCount([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
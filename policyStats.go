@@ -0,0 +1,176 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// policyTypes are the policy endpoints collected by the policy subsystem.
+var policyTypes = []string{`responderpolicy`, `rewritepolicy`, `cspolicy`}
+
+// PolicyStats represents the data returned from the /stat/responderpolicy, /stat/rewritepolicy and /stat/cspolicy Nitro API endpoints
+type PolicyStats struct {
+	Name       string      `json:"name"`
+	Hits       NitroNumber `json:"pipolicyhits"`
+	UndefHits  NitroNumber `json:"pipolicyundefhits"`
+	CSHits     NitroNumber `json:"cspolicyhits"`
+	policyType string
+	bindPoints []string
+}
+
+// NitroType implements the NitroData interface.
+func (s PolicyStats) NitroType() string {
+	return policySubsystem
+}
+
+// TotalHits returns the hit count regardless of which field the policy type reports it in.
+func (s PolicyStats) TotalHits() float64 {
+	return s.Hits.Value() + s.CSHits.Value()
+}
+
+func processPolicyStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := policySubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			policies, err := GetPolicyStats(P)
+			switch {
+			case err != nil:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				P.insertBackoff(thisSS)
+			default:
+				P.logger.Debug("processing policy stats", zap.String("subSystem", thisSS), zap.Int("number of policies", len(policies)))
+				for _, pol := range policies {
+					req := newNitroDataReq(pol)
+					success := P.submit(req)
+					if !success {
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+				timeEnd := time.Now().UnixNano()
+				exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+// GetPolicyStats retrieves hit counts for responder, rewrite and cs policies along with the bind points they are bound to.
+// Each policy type is collected independently, an error is only returned when no policy type could be retrieved.
+func GetPolicyStats(P *Pool) ([]PolicyStats, error) {
+	var policies []PolicyStats
+	var failures int
+	for _, policyType := range policyTypes {
+		stats, err := getPolicyTypeStats(P, policyType)
+		if err != nil {
+			P.logger.Error("error retrieving policy stats", zap.String("subSystem", policySubsystem), zap.String("policyType", policyType), zap.Error(err))
+			exporterAPICollectFailures.WithLabelValues(P.nsInstance, policySubsystem).Inc()
+			failures++
+			continue
+		}
+		policies = append(policies, stats...)
+	}
+	if failures == len(policyTypes) {
+		return policies, fmt.Errorf("error receiving data")
+	}
+	return policies, nil
+}
+
+// getPolicyTypeStats retrieves hit counts for all policies of the given type.
+// Hits are still returned without bind points if the bindings cannot be retrieved.
+func getPolicyTypeStats(P *Pool, policyType string) ([]PolicyStats, error) {
+	var stats []PolicyStats
+	b := submitAPITask(P, P.nitroStat(policyType))
+	if len(b) < 1 {
+		return stats, fmt.Errorf("error receiving data")
+	}
+	var tmp map[string]json.RawMessage
+	err := json.Unmarshal(b, &tmp)
+	if err != nil {
+		return stats, err
+	}
+	raw, ok := tmp[policyType]
+	if !ok {
+		return stats, nil
+	}
+	err = json.Unmarshal(raw, &stats)
+	if err != nil {
+		return stats, err
+	}
+	bindMap, err := getPolicyBindPoints(P, policyType)
+	if err != nil {
+		P.logger.Error("error retrieving policy bind points", zap.String("subSystem", policySubsystem), zap.String("policyType", policyType), zap.Error(err))
+		exporterMissedMetrics.WithLabelValues(P.nsInstance, policySubsystem).Inc()
+	}
+	for i := range stats {
+		stats[i].policyType = policyType
+		stats[i].bindPoints = bindMap[stats[i].Name]
+	}
+	return stats, nil
+}
+
+// getPolicyBindPoints returns the bind points for each policy of the given type, keyed by policy name.
+// Global bindings without a named entity are reported using the binding type, eg. responderglobal.
+func getPolicyBindPoints(P *Pool, policyType string) (map[string][]string, error) {
+	bindMap := make(map[string][]string)
+	b := submitAPITask(P, P.nitroConfig(policyType+`_binding?bulkbindings=yes`))
+	if len(b) < 1 {
+		return bindMap, fmt.Errorf("error receiving data")
+	}
+	var resp map[string]json.RawMessage
+	err := json.Unmarshal(b, &resp)
+	if err != nil {
+		return bindMap, err
+	}
+	raw, ok := resp[policyType+`_binding`]
+	if !ok {
+		return bindMap, nil
+	}
+	var bindings []map[string]json.RawMessage
+	err = json.Unmarshal(raw, &bindings)
+	if err != nil {
+		return bindMap, err
+	}
+	for _, binding := range bindings {
+		var name string
+		if err := json.Unmarshal(binding[`name`], &name); err != nil {
+			continue
+		}
+		for key, val := range binding {
+			if !strings.HasSuffix(key, `_binding`) {
+				continue
+			}
+			var boundTo []struct {
+				BoundTo string `json:"boundto"`
+			}
+			if err := json.Unmarshal(val, &boundTo); err != nil {
+				continue
+			}
+			for _, bt := range boundTo {
+				bindPoint := bt.BoundTo
+				if bindPoint == "" {
+					bindPoint = strings.TrimSuffix(strings.TrimPrefix(key, policyType+`_`), `_binding`)
+				}
+				bindMap[name] = append(bindMap[name], bindPoint)
+			}
+		}
+	}
+	return bindMap, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/responder/responderpolicy/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/rewrite/rewritepolicy/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/cs/cspolicy/
+
+const policySubsystem = `policy`
+
+var (
+	policyLabels = []string{netscalerInstance, `citrixadc_policy_type`, `citrixadc_policy_name`}
+	policyHits   = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: policySubsystem,
+			Name:      "hits_total",
+			Help:      "Total number of hits on the policy",
+		},
+		policyLabels,
+	)
+
+	policyUndefHits = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: policySubsystem,
+			Name:      "undef_hits_total",
+			Help:      "Total number of undefined hits on the policy",
+		},
+		policyLabels,
+	)
+)
+
+var (
+	policyBindingLabels = []string{netscalerInstance, `citrixadc_policy_type`, `citrixadc_policy_name`, `citrixadc_policy_bind_point`}
+	policyBindingInfo   = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: policySubsystem,
+			Name:      "binding_info",
+			Help:      "A metric with a constant '1' value linking a policy to a bind point it is bound to.",
+		},
+		policyBindingLabels,
+	)
+)
+
+func (P *Pool) promPolicyStats(ss PolicyStats) {
+	policyHits.WithLabelValues(P.nsInstance, ss.policyType, ss.Name).Set(ss.TotalHits())
+	policyUndefHits.WithLabelValues(P.nsInstance, ss.policyType, ss.Name).Set(ss.UndefHits.Value())
+	P.labelTTLs.setTTL(policyCollection, P.nsInstance, ss.policyType, ss.Name)
+	for _, bindPoint := range ss.bindPoints {
+		policyBindingInfo.WithLabelValues(P.nsInstance, ss.policyType, ss.Name, bindPoint).Set(1)
+		P.labelTTLs.setTTL(policyBindingCollection, P.nsInstance, ss.policyType, ss.Name, bindPoint)
+	}
+}
+
+var policyCollection = gaugeCollection{
+	policyHits,
+	policyUndefHits,
+}
+
+var policyBindingCollection = gaugeCollection{
+	policyBindingInfo,
+}
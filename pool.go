@@ -20,6 +20,7 @@ type Pool struct {
 	poolLock        *sync.Mutex
 	poolWG          sync.WaitGroup
 	backoff         *MiscMap
+	haRoles         *MiscMap
 	poolFlipBit     *FlipBit
 	mappingFlipBit  *FlipBit
 	metricClients   map[string]*netscaler.NitroClient
@@ -59,10 +60,15 @@ func newPool(lbs LBServer, logger *zap.Logger, loglevel string) *Pool {
 			data: make(map[string]interface{}, len(lbs.Metrics)),
 			lock: sync.Mutex{},
 		},
+		haRoles: &MiscMap{
+			data: make(map[string]interface{}),
+			lock: sync.Mutex{},
+		},
 		nsInstance: nsInstance(lbs.URL),
 		logger:     logger.With(zap.String(`nsInstance`, nsInstance(lbs.URL))),
 		labelTTLs: &LabelTTLs{
 			labelValues: make(map[uint64]map[uint64]*LabelValues, 0),
+			current:     make(map[currentKey][]string),
 			ttl:         time.Minute * 5,
 			lock:        sync.Mutex{},
 		},
@@ -329,52 +335,925 @@ func (p *Pool) nitroRawTask(req work.TaskRequest) {
 		datReq := newNitroDataReq(stats)
 		noErr = p.submit(datReq)
 		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
-	}
-	R.ResultChan() <- noErr
-	close(R.ResultChan())
-	p.logger.Debug("Completed nitroRaw Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
-}
-
-func (p *Pool) nitroDataTask(req work.TaskRequest) {
-	timeNow := time.Now().UnixNano()
-	var success bool
-	var sub string
-	p.logger.Debug("Recieved nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
-	R := req.(*nitroTaskReq)
-	switch data := R.data.(type) {
-	case ServiceStats:
-		sub = servicesSubsystem
-		p.logger.Debug("Identified nitroData Task Type as ServiceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
-		promReq := newPromTask(data)
-		success = p.submit(promReq)
-		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
-	case LBVServerStats:
-		sub = lbvserverSubsystem
-		p.logger.Debug("Identified nitroData Task Type as LBVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
-		promReq := newPromTask(data)
-		success = p.submit(promReq)
-		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
-	case LBVServerConfigs:
-		sub = lbvserverConfigSubsystem
-		p.logger.Debug("Identified nitroData Task Type as LBVServerConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
-		promReq := newPromTask(data)
-		success = p.submit(promReq)
-		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
-	case GSLBVServerStats:
-		sub = gslbVServerSubsystem
-		p.logger.Debug("Identified nitroData Task Type as GSLBVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
-		promReq := newPromTask(data)
-		success = p.submit(promReq)
-		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
-	case NSStats:
-		sub = nsSubsystem
-		p.logger.Debug("Identified nitroData Task Type as NSStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
-		promReq := newPromTask(data)
-		success = p.submit(promReq)
-		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
-	case SSLStats:
-		sub = sslSubsystem
-		p.logger.Debug("Identified nitroData Task Type as SSLStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+	case RawCSVServerStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawCSVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []CSVServerStats
+		tmp := struct {
+			Target *[]CSVServerStats `json:"csvserver"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawCSVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawCSVServerPolicyBindings:
+		p.logger.Debug("Identified nitroRaw Task Type as RawCSVServerPolicyBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []CSVServerPolicyBindings
+		tmp := struct {
+			Target *[]CSVServerPolicyBindings `json:"csvserver_cspolicy_binding"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawCSVServerPolicyBindings", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawCSVServerLBVServerBindings:
+		p.logger.Debug("Identified nitroRaw Task Type as RawCSVServerLBVServerBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []CSVServerLBVServerBindings
+		tmp := struct {
+			Target *[]CSVServerLBVServerBindings `json:"csvserver_lbvserver_binding"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawCSVServerLBVServerBindings", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawHANodeStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawHANodeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats HANodeStats
+		tmp := struct {
+			Target *HANodeStats `json:"hanode"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawHANodeStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawHANodeConfigs:
+		p.logger.Debug("Identified nitroRaw Task Type as RawHANodeConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []HANodeConfigs
+		tmp := struct {
+			Target *[]HANodeConfigs `json:"hanode"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawHANodeConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawClusterNodeStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawClusterNodeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []ClusterNodeStats
+		tmp := struct {
+			Target *[]ClusterNodeStats `json:"clusternode"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawClusterNodeStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawClusterInstanceStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawClusterInstanceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []ClusterInstanceStats
+		tmp := struct {
+			Target *[]ClusterInstanceStats `json:"clusterinstance"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawClusterInstanceStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawSSLCertKeyConfigs:
+		p.logger.Debug("Identified nitroRaw Task Type as RawSSLCertKeyConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []SSLCertKeyConfigs
+		tmp := struct {
+			Target *[]SSLCertKeyConfigs `json:"sslcertkey"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawSSLCertKeyConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawSSLCertKeyBindings:
+		p.logger.Debug("Identified nitroRaw Task Type as RawSSLCertKeyBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []SSLCertKeyBindings
+		tmp := struct {
+			Target *[]SSLCertKeyBindings `json:"sslcertkey_sslvserver_binding"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawSSLCertKeyBindings", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawSSLVServerStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawSSLVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []SSLVServerStats
+		tmp := struct {
+			Target *[]SSLVServerStats `json:"sslvserver"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawSSLVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawProtocolTCPStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawProtocolTCPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats ProtocolTCPStats
+		tmp := struct {
+			Target *ProtocolTCPStats `json:"protocoltcp"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawProtocolTCPStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawProtocolHTTPStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawProtocolHTTPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats ProtocolHTTPStats
+		tmp := struct {
+			Target *ProtocolHTTPStats `json:"protocolhttp"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawProtocolHTTPStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawProtocolIPStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawProtocolIPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats ProtocolIPStats
+		tmp := struct {
+			Target *ProtocolIPStats `json:"protocolip"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawProtocolIPStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawSystemCPUStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawSystemCPUStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []SystemCPUStats
+		tmp := struct {
+			Target *[]SystemCPUStats `json:"systemcpu"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawSystemCPUStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawSystemStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawSystemStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats SystemStats
+		tmp := struct {
+			Target *SystemStats `json:"system"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawSystemStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawSystemMemoryStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawSystemMemoryStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats SystemMemoryStats
+		tmp := struct {
+			Target *SystemMemoryStats `json:"systemmemory"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawSystemMemoryStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawAAAStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawAAAStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats AAAStats
+		tmp := struct {
+			Target *AAAStats `json:"aaa"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawAAAStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawVPNVServerStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawVPNVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []VPNVServerStats
+		tmp := struct {
+			Target *[]VPNVServerStats `json:"vpnvserver"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawVPNVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawCacheStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawCacheStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats CacheStats
+		tmp := struct {
+			Target *CacheStats `json:"cache"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawCacheStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawCMPStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawCMPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats CMPStats
+		tmp := struct {
+			Target *CMPStats `json:"cmp"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawCMPStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawAppFWStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawAppFWStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats AppFWStats
+		tmp := struct {
+			Target *AppFWStats `json:"appfw"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawAppFWStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawAppFWProfileStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawAppFWProfileStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []AppFWProfileStats
+		tmp := struct {
+			Target *[]AppFWProfileStats `json:"appfwprofile"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawAppFWProfileStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawDNSStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawDNSStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats DNSStats
+		tmp := struct {
+			Target *DNSStats `json:"dns"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawDNSStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawGSLBDomainStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawGSLBDomainStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []GSLBDomainStats
+		tmp := struct {
+			Target *[]GSLBDomainStats `json:"gslbdomain"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawGSLBDomainStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawServerConfigs:
+		p.logger.Debug("Identified nitroRaw Task Type as RawServerConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []ServerConfigs
+		tmp := struct {
+			Target *[]ServerConfigs `json:"server"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawServerConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawServiceMonitorBindings:
+		p.logger.Debug("Identified nitroRaw Task Type as RawServiceMonitorBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []ServiceMonitorBindings
+		tmp := struct {
+			Target *[]ServiceMonitorBindings `json:"service_lbmonitor_binding"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawServiceMonitorBindings", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawVLANStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawVLANStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []VLANStats
+		tmp := struct {
+			Target *[]VLANStats `json:"vlan"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawVLANStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawBridgeStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawBridgeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats BridgeStats
+		tmp := struct {
+			Target *BridgeStats `json:"bridge"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawBridgeStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawLSNStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawLSNStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats LSNStats
+		tmp := struct {
+			Target *LSNStats `json:"lsn"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		p.logger.Debug("Processed RawLSNStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", 1), zap.Int64("TaskTS", timeNow))
+		datReq := newNitroDataReq(stats)
+		noErr = p.submit(datReq)
+		p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", noErr))
+	case RawLSNGroupStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawLSNGroupStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []LSNGroupStats
+		tmp := struct {
+			Target *[]LSNGroupStats `json:"lsngroup"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawLSNGroupStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawNSLimitIdentifierStats:
+		p.logger.Debug("Identified nitroRaw Task Type as RawNSLimitIdentifierStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []NSLimitIdentifierStats
+		tmp := struct {
+			Target *[]NSLimitIdentifierStats `json:"nslimitidentifier"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawNSLimitIdentifierStats", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	case RawServiceGroupMonitorBindings:
+		p.logger.Debug("Identified nitroRaw Task Type as RawServiceGroupMonitorBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		var stats []ServiceGroupMonitorBindings
+		tmp := struct {
+			Target *[]ServiceGroupMonitorBindings `json:"servicegroup_lbmonitor_binding"`
+		}{Target: &stats}
+		err := json.Unmarshal(data, &tmp)
+		if err != nil {
+			p.logger.Error("Recieved nitroRaw Task Error", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Error(err))
+			R.ResultChan() <- false
+			close(R.ResultChan())
+			return
+		}
+		for _, s := range stats {
+			datReq := newNitroDataReq(s)
+			success := p.submit(datReq)
+			p.logger.Debug("Sending nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+			if !success {
+				noErr = false
+			}
+		}
+		p.logger.Debug("Processed RawServiceGroupMonitorBindings", zap.String("TaskType", req.ReqType().String()), zap.Int("Number of Stats", len(stats)), zap.Int64("TaskTS", timeNow))
+	}
+	R.ResultChan() <- noErr
+	close(R.ResultChan())
+	p.logger.Debug("Completed nitroRaw Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+}
+
+func (p *Pool) nitroDataTask(req work.TaskRequest) {
+	timeNow := time.Now().UnixNano()
+	var success bool
+	var sub string
+	p.logger.Debug("Recieved nitroData Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+	R := req.(*nitroTaskReq)
+	switch data := R.data.(type) {
+	case ServiceStats:
+		sub = servicesSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ServiceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case LBVServerStats:
+		sub = lbvserverSubsystem
+		p.logger.Debug("Identified nitroData Task Type as LBVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case LBVServerConfigs:
+		sub = lbvserverConfigSubsystem
+		p.logger.Debug("Identified nitroData Task Type as LBVServerConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case GSLBVServerStats:
+		sub = gslbVServerSubsystem
+		p.logger.Debug("Identified nitroData Task Type as GSLBVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case NSStats:
+		sub = nsSubsystem
+		p.logger.Debug("Identified nitroData Task Type as NSStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case SSLStats:
+		sub = sslSubsystem
+		p.logger.Debug("Identified nitroData Task Type as SSLStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case CSVServerStats:
+		sub = csvserverSubsystem
+		p.logger.Debug("Identified nitroData Task Type as CSVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case CSVServerPolicyBindings:
+		sub = csvserverPolicySubsystem
+		p.logger.Debug("Identified nitroData Task Type as CSVServerPolicyBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case CSVServerLBVServerBindings:
+		sub = csvserverPolicySubsystem
+		p.logger.Debug("Identified nitroData Task Type as CSVServerLBVServerBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ServiceGroupStats:
+		sub = servicegroupSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ServiceGroupStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case InterfaceStats:
+		sub = interfaceSubsystem
+		p.logger.Debug("Identified nitroData Task Type as InterfaceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case HANodeStats:
+		sub = haSubsystem
+		p.logger.Debug("Identified nitroData Task Type as HANodeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case HANodeConfigs:
+		sub = haSubsystem
+		p.logger.Debug("Identified nitroData Task Type as HANodeConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ClusterNodeStats:
+		sub = clusterSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ClusterNodeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ClusterInstanceStats:
+		sub = clusterSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ClusterInstanceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case SSLCertKeyConfigs:
+		sub = sslCertKeySubsystem
+		p.logger.Debug("Identified nitroData Task Type as SSLCertKeyConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case SSLCertKeyBindings:
+		sub = sslCertKeySubsystem
+		p.logger.Debug("Identified nitroData Task Type as SSLCertKeyBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case SSLVServerStats:
+		sub = sslVServerSubsystem
+		p.logger.Debug("Identified nitroData Task Type as SSLVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ProtocolTCPStats:
+		sub = protocolTCPSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ProtocolTCPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ProtocolHTTPStats:
+		sub = protocolHTTPSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ProtocolHTTPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ProtocolIPStats:
+		sub = protocolIPSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ProtocolIPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case SystemCPUStats:
+		sub = nsCPUSubsystem
+		p.logger.Debug("Identified nitroData Task Type as SystemCPUStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case SystemStats:
+		sub = memorySubsystem
+		p.logger.Debug("Identified nitroData Task Type as SystemStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case SystemMemoryStats:
+		sub = memorySubsystem
+		p.logger.Debug("Identified nitroData Task Type as SystemMemoryStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case AAAStats:
+		sub = aaaSubsystem
+		p.logger.Debug("Identified nitroData Task Type as AAAStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case VPNVServerStats:
+		sub = vpnSubsystem
+		p.logger.Debug("Identified nitroData Task Type as VPNVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case CacheStats:
+		sub = cacheSubsystem
+		p.logger.Debug("Identified nitroData Task Type as CacheStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case CMPStats:
+		sub = cmpSubsystem
+		p.logger.Debug("Identified nitroData Task Type as CMPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case AppFWStats:
+		sub = appfwSubsystem
+		p.logger.Debug("Identified nitroData Task Type as AppFWStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case AppFWProfileStats:
+		sub = appfwSubsystem
+		p.logger.Debug("Identified nitroData Task Type as AppFWProfileStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case PolicyStats:
+		sub = policySubsystem
+		p.logger.Debug("Identified nitroData Task Type as PolicyStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case DNSStats:
+		sub = dnsSubsystem
+		p.logger.Debug("Identified nitroData Task Type as DNSStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case GSLBDomainStats:
+		sub = gslbDomainSubsystem
+		p.logger.Debug("Identified nitroData Task Type as GSLBDomainStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case GSLBSiteStats:
+		sub = gslbSiteSubsystem
+		p.logger.Debug("Identified nitroData Task Type as GSLBSiteStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ServerConfigs:
+		sub = serverSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ServerConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ServiceMonitorBindings:
+		sub = monitorSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ServiceMonitorBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case CapacityStats:
+		sub = capacitySubsystem
+		p.logger.Debug("Identified nitroData Task Type as CapacityStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case VLANStats:
+		sub = vlanSubsystem
+		p.logger.Debug("Identified nitroData Task Type as VLANStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case BridgeStats:
+		sub = bridgeSubsystem
+		p.logger.Debug("Identified nitroData Task Type as BridgeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case LSNStats:
+		sub = lsnSubsystem
+		p.logger.Debug("Identified nitroData Task Type as LSNStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case LSNGroupStats:
+		sub = lsnGroupSubsystem
+		p.logger.Debug("Identified nitroData Task Type as LSNGroupStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case NSLimitIdentifierStats:
+		sub = nslimitSubsystem
+		p.logger.Debug("Identified nitroData Task Type as NSLimitIdentifierStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case PersistenceStats:
+		sub = persistenceSubsystem
+		p.logger.Debug("Identified nitroData Task Type as PersistenceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case NSConfigStats:
+		sub = nsConfigSubsystem
+		p.logger.Debug("Identified nitroData Task Type as NSConfigStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		promReq := newPromTask(data)
+		success = p.submit(promReq)
+		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
+	case ServiceGroupMonitorBindings:
+		sub = monitorServiceGroupSubsystem
+		p.logger.Debug("Identified nitroData Task Type as ServiceGroupMonitorBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
 		promReq := newPromTask(data)
 		success = p.submit(promReq)
 		p.logger.Debug("Sending nitroProm Task", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow), zap.Bool("successful", success))
@@ -411,6 +1290,123 @@ func (p *Pool) nitroPromTask(req work.TaskRequest) {
 	case SSLStats:
 		p.logger.Debug("Identified nitroProm Task Type as SSLStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
 		p.promSSLStats(data)
+	case CSVServerStats:
+		p.logger.Debug("Identified nitroProm Task Type as CSVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promCSVServerStats(data)
+	case CSVServerPolicyBindings:
+		p.logger.Debug("Identified nitroProm Task Type as CSVServerPolicyBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promCSVServerBindings(data)
+	case CSVServerLBVServerBindings:
+		p.logger.Debug("Identified nitroProm Task Type as CSVServerLBVServerBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promCSVServerBindings(data)
+	case ServiceGroupStats:
+		p.logger.Debug("Identified nitroProm Task Type as ServiceGroupStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promServiceGroupStats(data)
+	case InterfaceStats:
+		p.logger.Debug("Identified nitroProm Task Type as InterfaceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promInterfaceStats(data)
+	case HANodeStats:
+		p.logger.Debug("Identified nitroProm Task Type as HANodeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promHAStats(data)
+	case HANodeConfigs:
+		p.logger.Debug("Identified nitroProm Task Type as HANodeConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promHAStats(data)
+	case ClusterNodeStats:
+		p.logger.Debug("Identified nitroProm Task Type as ClusterNodeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promClusterStats(data)
+	case ClusterInstanceStats:
+		p.logger.Debug("Identified nitroProm Task Type as ClusterInstanceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promClusterStats(data)
+	case SSLCertKeyConfigs:
+		p.logger.Debug("Identified nitroProm Task Type as SSLCertKeyConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promSSLCertKeyConfigs(data)
+	case SSLCertKeyBindings:
+		p.logger.Debug("Identified nitroProm Task Type as SSLCertKeyBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promSSLCertKeyConfigs(data)
+	case SSLVServerStats:
+		p.logger.Debug("Identified nitroProm Task Type as SSLVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promSSLVServerStats(data)
+	case ProtocolTCPStats:
+		p.logger.Debug("Identified nitroProm Task Type as ProtocolTCPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promProtocolTCPStats(data)
+	case ProtocolHTTPStats:
+		p.logger.Debug("Identified nitroProm Task Type as ProtocolHTTPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promProtocolHTTPStats(data)
+	case ProtocolIPStats:
+		p.logger.Debug("Identified nitroProm Task Type as ProtocolIPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promProtocolIPStats(data)
+	case SystemCPUStats:
+		p.logger.Debug("Identified nitroProm Task Type as SystemCPUStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promSystemCPUStats(data)
+	case SystemStats:
+		p.logger.Debug("Identified nitroProm Task Type as SystemStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promSystemStats(data)
+	case SystemMemoryStats:
+		p.logger.Debug("Identified nitroProm Task Type as SystemMemoryStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promSystemMemoryStats(data)
+	case AAAStats:
+		p.logger.Debug("Identified nitroProm Task Type as AAAStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promAAAStats(data)
+	case VPNVServerStats:
+		p.logger.Debug("Identified nitroProm Task Type as VPNVServerStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promVPNVServerStats(data)
+	case CacheStats:
+		p.logger.Debug("Identified nitroProm Task Type as CacheStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promCacheStats(data)
+	case CMPStats:
+		p.logger.Debug("Identified nitroProm Task Type as CMPStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promCMPStats(data)
+	case AppFWStats:
+		p.logger.Debug("Identified nitroProm Task Type as AppFWStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promAppFWStats(data)
+	case AppFWProfileStats:
+		p.logger.Debug("Identified nitroProm Task Type as AppFWProfileStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promAppFWProfileStats(data)
+	case PolicyStats:
+		p.logger.Debug("Identified nitroProm Task Type as PolicyStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promPolicyStats(data)
+	case DNSStats:
+		p.logger.Debug("Identified nitroProm Task Type as DNSStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promDNSStats(data)
+	case GSLBDomainStats:
+		p.logger.Debug("Identified nitroProm Task Type as GSLBDomainStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promGSLBDomainStats(data)
+	case GSLBSiteStats:
+		p.logger.Debug("Identified nitroProm Task Type as GSLBSiteStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promGSLBSiteStats(data)
+	case ServerConfigs:
+		p.logger.Debug("Identified nitroProm Task Type as ServerConfigs", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promServerConfigs(data)
+	case ServiceMonitorBindings:
+		p.logger.Debug("Identified nitroProm Task Type as ServiceMonitorBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promServiceMonitorBindings(data)
+	case CapacityStats:
+		p.logger.Debug("Identified nitroProm Task Type as CapacityStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promCapacityStats(data)
+	case VLANStats:
+		p.logger.Debug("Identified nitroProm Task Type as VLANStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promVLANStats(data)
+	case BridgeStats:
+		p.logger.Debug("Identified nitroProm Task Type as BridgeStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promBridgeStats(data)
+	case LSNStats:
+		p.logger.Debug("Identified nitroProm Task Type as LSNStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promLSNStats(data)
+	case LSNGroupStats:
+		p.logger.Debug("Identified nitroProm Task Type as LSNGroupStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promLSNGroupStats(data)
+	case NSLimitIdentifierStats:
+		p.logger.Debug("Identified nitroProm Task Type as NSLimitIdentifierStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promNSLimitIdentifierStats(data)
+	case PersistenceStats:
+		p.logger.Debug("Identified nitroProm Task Type as PersistenceStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promPersistenceStats(data)
+	case NSConfigStats:
+		p.logger.Debug("Identified nitroProm Task Type as NSConfigStats", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promNSConfigStats(data)
+	case ServiceGroupMonitorBindings:
+		p.logger.Debug("Identified nitroProm Task Type as ServiceGroupMonitorBindings", zap.String("TaskType", req.ReqType().String()), zap.Int64("TaskTS", timeNow))
+		p.promServiceGroupMonitorBindings(data)
 	}
 	if R.ResultChan() != nil {
 		close(R.ResultChan())
@@ -34,10 +34,17 @@ type LabelValues struct {
 // LabelTTLs contains LabelValues and a TTL value.
 type LabelTTLs struct {
 	labelValues map[uint64]map[uint64]*LabelValues
+	current     map[currentKey][]string
 	ttl         time.Duration
 	lock        sync.Mutex
 }
 
+// currentKey identifies the single series of a GaugeVec which represents an entity.
+type currentKey struct {
+	gaugeVec *prometheus.GaugeVec
+	key      string
+}
+
 func (L *LabelTTLs) setTTL(c metricCollection, labels ...string) {
 	L.lock.Lock()
 	timeNow := time.Now()
@@ -90,6 +97,43 @@ func (L *LabelTTLs) setTTL(c metricCollection, labels ...string) {
 	L.lock.Unlock()
 }
 
+// setCurrent registers labels with a timestamp as setTTL does, but treats the labels as the only current
+// series for the entity identified by key. Series previously registered for the key with different labels
+// are deleted immediately, rather than left to expire, so info metrics never report two values at once.
+func (L *LabelTTLs) setCurrent(c gaugeCollection, key string, labels ...string) {
+	L.lock.Lock()
+	for _, C := range c {
+		k := currentKey{gaugeVec: C, key: key}
+		if prev, ok := L.current[k]; ok && !sameLabels(prev, labels) {
+			C.DeleteLabelValues(prev...)
+		}
+		L.current[k] = labels
+	}
+	L.lock.Unlock()
+	L.setTTL(c, labels...)
+}
+
+// deleteCurrent removes the entity tracked by setCurrent for an expired series. The caller must hold the lock.
+func (L *LabelTTLs) deleteCurrent(gaugeVec *prometheus.GaugeVec, labels []string) {
+	for k, current := range L.current {
+		if k.gaugeVec == gaugeVec && sameLabels(current, labels) {
+			delete(L.current, k)
+		}
+	}
+}
+
+func sameLabels(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (L *LabelTTLs) deleteStale() {
 	L.lock.Lock()
 	timeNow := time.Now()
@@ -99,6 +143,7 @@ func (L *LabelTTLs) deleteStale() {
 				switch {
 				case labels.gaugeVec != nil:
 					labels.gaugeVec.DeleteLabelValues(labels.labels...)
+					L.deleteCurrent(labels.gaugeVec, labels.labels)
 				case labels.countVec != nil:
 					labels.countVec.DeleteLabelValues(labels.labels...)
 				}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestLabelTTLsSetCurrent(t *testing.T) {
+	gauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "test",
+			Name:      "target_info",
+			Help:      "A metric with a constant '1' value linking a test entity to its current target.",
+		},
+		[]string{"name", "target"},
+	)
+	L := &LabelTTLs{
+		labelValues: make(map[uint64]map[uint64]*LabelValues),
+		current:     make(map[currentKey][]string),
+		ttl:         time.Minute,
+	}
+	c := gaugeCollection{gauge}
+
+	gauge.WithLabelValues("a", "one").Set(1)
+	L.setCurrent(c, "a", "a", "one")
+	gauge.WithLabelValues("a", "two").Set(1)
+	L.setCurrent(c, "a", "a", "two")
+	if got := testutil.CollectAndCount(gauge); got != 1 {
+		t.Fatalf("series after label change = %d, want 1", got)
+	}
+
+	gauge.WithLabelValues("b", "one").Set(1)
+	L.setCurrent(c, "b", "b", "one")
+	if got := len(L.current); got != 2 {
+		t.Fatalf("current entries = %d, want 2", got)
+	}
+
+	L.ttl = -time.Minute
+	L.deleteStale()
+	if got := testutil.CollectAndCount(gauge); got != 0 {
+		t.Errorf("series after expiry = %d, want 0", got)
+	}
+	if got := len(L.current); got != 0 {
+		t.Errorf("current entries after expiry = %d, want 0", got)
+	}
+}
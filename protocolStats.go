@@ -0,0 +1,163 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawProtocolTCPStats is the payload as returned by the Nitro API.
+type RawProtocolTCPStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawProtocolTCPStats) Len() int {
+	return len(r)
+}
+
+// RawProtocolHTTPStats is the payload as returned by the Nitro API.
+type RawProtocolHTTPStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawProtocolHTTPStats) Len() int {
+	return len(r)
+}
+
+// RawProtocolIPStats is the payload as returned by the Nitro API.
+type RawProtocolIPStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawProtocolIPStats) Len() int {
+	return len(r)
+}
+
+// ProtocolTCPStats represents the data returned from the /stat/protocoltcp Nitro API endpoint
+type ProtocolTCPStats struct {
+	TotalRxPkts           string `json:"tcptotrxpkts"`
+	TotalRxBytes          string `json:"tcptotrxbytes"`
+	TotalTxPkts           string `json:"tcptottxpkts"`
+	TotalTxBytes          string `json:"tcptottxbytes"`
+	TotalSyn              string `json:"tcptotsyn"`
+	TotalSynHeld          string `json:"tcptotsynheld"`
+	TotalSynProbes        string `json:"tcptotsynprobe"`
+	TotalSynFlushed       string `json:"tcptotsynflush"`
+	TotalSynDropped       string `json:"tcperrsyndroppedcongestion"`
+	ErrFullRetransmits    string `json:"tcperrfullretrasmit"`
+	ErrPartialRetransmits string `json:"tcperrpartialretrasmit"`
+	ErrRetransmitGiveUp   string `json:"tcperrretransmitgiveup"`
+	ErrFastRetransmits    string `json:"tcperrfastretransmissions"`
+	ErrRstOutOfWindow     string `json:"tcperrrstoutofwindow"`
+	ErrRstThreshold       string `json:"tcperrrstthreshold"`
+	ErrSynRetry           string `json:"tcperrsynretry"`
+	ErrSynGiveUp          string `json:"tcperrsyngiveup"`
+	ErrSynSentBadAck      string `json:"tcperrsynsentbadack"`
+	ErrBadChecksum        string `json:"tcperrbadchecksum"`
+}
+
+// ProtocolHTTPStats represents the data returned from the /stat/protocolhttp Nitro API endpoint
+type ProtocolHTTPStats struct {
+	TotalRequests          string `json:"httptotrequests"`
+	TotalResponses         string `json:"httptotresponses"`
+	TotalRxRequestBytes    string `json:"httptotrxrequestbytes"`
+	TotalRxResponseBytes   string `json:"httptotrxresponsebytes"`
+	TotalGets              string `json:"httptotgets"`
+	TotalPosts             string `json:"httptotposts"`
+	TotalOthers            string `json:"httptotothers"`
+	ErrIncompleteHeaders   string `json:"httperrincompleteheaders"`
+	ErrIncompleteRequests  string `json:"httperrincompleterequests"`
+	ErrIncompleteResponses string `json:"httperrincompleteresponses"`
+	ErrServerBusy          string `json:"httperrserverbusy"`
+	ErrLargeContent        string `json:"httperrlargecontent"`
+	ErrLargeChunk          string `json:"httperrlargechunk"`
+	ErrLargeContentLength  string `json:"httperrlargectlen"`
+	Total4xxResponses      string `json:"httptot4xxresponses"`
+	Total5xxResponses      string `json:"httptot5xxresponses"`
+}
+
+// ProtocolIPStats represents the data returned from the /stat/protocolip Nitro API endpoint
+type ProtocolIPStats struct {
+	TotalRxPkts             string `json:"iptotrxpkts"`
+	TotalRxBytes            string `json:"iptotrxbytes"`
+	TotalTxPkts             string `json:"iptottxpkts"`
+	TotalTxBytes            string `json:"iptottxbytes"`
+	TotalFragments          string `json:"iptotfragments"`
+	TotalReassemblyAttempts string `json:"iptotreassemblyattempt"`
+	TotalSuccessReassembly  string `json:"iptotsuccreassembly"`
+	ErrBadChecksums         string `json:"iptotbadchecksums"`
+	ErrBadLengths           string `json:"iptotbadlens"`
+	ErrBadMacAddresses      string `json:"iptotbadmacaddrs"`
+	ErrTruncatedPackets     string `json:"iptottruncatedpackets"`
+	ErrUnknownServices      string `json:"iptotunknownsvcpkts"`
+	ErrLandAttacks          string `json:"iptotlandattacks"`
+	ErrZeroSourceIP         string `json:"iptotzerosrcip"`
+}
+
+// NitroType implements the NitroData interface.
+func (s ProtocolTCPStats) NitroType() string {
+	return protocolTCPSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s ProtocolHTTPStats) NitroType() string {
+	return protocolHTTPSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s ProtocolIPStats) NitroType() string {
+	return protocolIPSubsystem
+}
+
+func processProtocolTCPStats(P *Pool, wg *sync.WaitGroup) {
+	processProtocolStats(P, wg, protocolTCPSubsystem, func(b []byte) NitroRaw { return RawProtocolTCPStats(b) })
+}
+
+func processProtocolHTTPStats(P *Pool, wg *sync.WaitGroup) {
+	processProtocolStats(P, wg, protocolHTTPSubsystem, func(b []byte) NitroRaw { return RawProtocolHTTPStats(b) })
+}
+
+func processProtocolIPStats(P *Pool, wg *sync.WaitGroup) {
+	processProtocolStats(P, wg, protocolIPSubsystem, func(b []byte) NitroRaw { return RawProtocolIPStats(b) })
+}
+
+// processProtocolStats collects a single /stat/protocol* endpoint. The subsystem names match the
+// endpoint names, so each protocol is fetched, and backs off, independently of the others.
+func processProtocolStats(P *Pool, wg *sync.WaitGroup, thisSS string, newRaw func([]byte) NitroRaw) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(thisSS))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(newRaw(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
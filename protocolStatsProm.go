@@ -0,0 +1,431 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/protocol/protocoltcp/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/protocol/protocolhttp/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/protocol/protocolip/
+
+const (
+	protocolTCPSubsystem  = `protocoltcp`
+	protocolHTTPSubsystem = `protocolhttp`
+	protocolIPSubsystem   = `protocolip`
+)
+
+var (
+	protocolTCPLabels = []string{netscalerInstance}
+	protocolTCPRxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "received_packets_total",
+			Help:      "Total number of TCP packets received",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPRxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "received_bytes_total",
+			Help:      "Total number of TCP bytes received",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPTxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "transmitted_packets_total",
+			Help:      "Total number of TCP packets transmitted",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPTxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "transmitted_bytes_total",
+			Help:      "Total number of TCP bytes transmitted",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPSyn = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_packets_total",
+			Help:      "Total number of SYN packets received",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPSynHeld = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_held_total",
+			Help:      "Total number of SYN packets held by SYN flood protection",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPSynProbes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_probes_total",
+			Help:      "Total number of probes sent to clients by SYN flood protection",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPSynFlushed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_flushed_total",
+			Help:      "Total number of held SYN packets flushed by SYN flood protection",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPSynDropped = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_dropped_total",
+			Help:      "Total number of SYN packets dropped because of network congestion",
+		},
+		protocolTCPLabels,
+	)
+
+	protocolTCPErrorLabels = []string{netscalerInstance, `citrixadc_tcp_error`}
+	protocolTCPErrors      = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "errors_total",
+			Help:      "Total number of TCP errors by type",
+		},
+		protocolTCPErrorLabels,
+	)
+)
+
+var (
+	protocolHTTPLabels   = []string{netscalerInstance}
+	protocolHTTPRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of HTTP requests received",
+		},
+		protocolHTTPLabels,
+	)
+
+	protocolHTTPResponses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of HTTP responses sent",
+		},
+		protocolHTTPLabels,
+	)
+
+	protocolHTTPRxRequestBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "request_bytes_total",
+			Help:      "Total number of bytes received in HTTP requests",
+		},
+		protocolHTTPLabels,
+	)
+
+	protocolHTTPRxResponseBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total number of bytes received in HTTP responses",
+		},
+		protocolHTTPLabels,
+	)
+
+	protocolHTTPGets = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "get_requests_total",
+			Help:      "Total number of HTTP requests received with the GET method",
+		},
+		protocolHTTPLabels,
+	)
+
+	protocolHTTPPosts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "post_requests_total",
+			Help:      "Total number of HTTP requests received with the POST method",
+		},
+		protocolHTTPLabels,
+	)
+
+	protocolHTTPOthers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "other_requests_total",
+			Help:      "Total number of HTTP requests received with methods other than GET and POST",
+		},
+		protocolHTTPLabels,
+	)
+
+	protocolHTTPErrorLabels = []string{netscalerInstance, `citrixadc_http_error`}
+	protocolHTTPErrors      = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "errors_total",
+			Help:      "Total number of HTTP errors by type",
+		},
+		protocolHTTPErrorLabels,
+	)
+
+	protocolHTTPResponseClassLabels = []string{netscalerInstance, `citrixadc_http_response_class`}
+	protocolHTTPResponseClasses     = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "responses_by_class_total",
+			Help:      "Total number of HTTP error responses by status code class",
+		},
+		protocolHTTPResponseClassLabels,
+	)
+)
+
+var (
+	protocolIPLabels = []string{netscalerInstance}
+	protocolIPRxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "received_packets_total",
+			Help:      "Total number of IP packets received",
+		},
+		protocolIPLabels,
+	)
+
+	protocolIPRxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "received_bytes_total",
+			Help:      "Total number of IP bytes received",
+		},
+		protocolIPLabels,
+	)
+
+	protocolIPTxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "transmitted_packets_total",
+			Help:      "Total number of IP packets transmitted",
+		},
+		protocolIPLabels,
+	)
+
+	protocolIPTxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "transmitted_bytes_total",
+			Help:      "Total number of IP bytes transmitted",
+		},
+		protocolIPLabels,
+	)
+
+	protocolIPFragments = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "fragments_total",
+			Help:      "Total number of IP fragments received",
+		},
+		protocolIPLabels,
+	)
+
+	protocolIPReassemblyAttempts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "reassembly_attempts_total",
+			Help:      "Total number of IP fragment reassemblies attempted",
+		},
+		protocolIPLabels,
+	)
+
+	protocolIPSuccessReassembly = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "reassembly_success_total",
+			Help:      "Total number of IP fragments successfully reassembled",
+		},
+		protocolIPLabels,
+	)
+
+	protocolIPErrorLabels = []string{netscalerInstance, `citrixadc_ip_error`}
+	protocolIPErrors      = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "errors_total",
+			Help:      "Total number of IP errors by type",
+		},
+		protocolIPErrorLabels,
+	)
+)
+
+func (P *Pool) promProtocolTCPStats(ss ProtocolTCPStats) {
+	protocolTCPRxPkts.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRxPkts))
+	protocolTCPRxBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRxBytes))
+	protocolTCPTxPkts.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalTxPkts))
+	protocolTCPTxBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalTxBytes))
+	protocolTCPSyn.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSyn))
+	protocolTCPSynHeld.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSynHeld))
+	protocolTCPSynProbes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSynProbes))
+	protocolTCPSynFlushed.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSynFlushed))
+	protocolTCPSynDropped.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSynDropped))
+
+	for errType, val := range map[string]string{
+		`full_retransmit`:    ss.ErrFullRetransmits,
+		`partial_retransmit`: ss.ErrPartialRetransmits,
+		`retransmit_give_up`: ss.ErrRetransmitGiveUp,
+		`fast_retransmit`:    ss.ErrFastRetransmits,
+		`rst_out_of_window`:  ss.ErrRstOutOfWindow,
+		`rst_threshold`:      ss.ErrRstThreshold,
+		`syn_retry`:          ss.ErrSynRetry,
+		`syn_give_up`:        ss.ErrSynGiveUp,
+		`syn_sent_bad_ack`:   ss.ErrSynSentBadAck,
+		`bad_checksum`:       ss.ErrBadChecksum,
+	} {
+		protocolTCPErrors.WithLabelValues(P.nsInstance, errType).Set(cast.ToFloat64(val))
+		P.labelTTLs.setTTL(protocolTCPErrorCollection, P.nsInstance, errType)
+	}
+	P.labelTTLs.setTTL(protocolTCPCollection, P.nsInstance)
+}
+
+func (P *Pool) promProtocolHTTPStats(ss ProtocolHTTPStats) {
+	protocolHTTPRequests.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRequests))
+	protocolHTTPResponses.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalResponses))
+	protocolHTTPRxRequestBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRxRequestBytes))
+	protocolHTTPRxResponseBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRxResponseBytes))
+	protocolHTTPGets.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalGets))
+	protocolHTTPPosts.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalPosts))
+	protocolHTTPOthers.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalOthers))
+
+	for errType, val := range map[string]string{
+		`incomplete_headers`:   ss.ErrIncompleteHeaders,
+		`incomplete_requests`:  ss.ErrIncompleteRequests,
+		`incomplete_responses`: ss.ErrIncompleteResponses,
+		`server_busy`:          ss.ErrServerBusy,
+		`large_content`:        ss.ErrLargeContent,
+		`large_chunk`:          ss.ErrLargeChunk,
+		`large_content_length`: ss.ErrLargeContentLength,
+	} {
+		protocolHTTPErrors.WithLabelValues(P.nsInstance, errType).Set(cast.ToFloat64(val))
+		P.labelTTLs.setTTL(protocolHTTPErrorCollection, P.nsInstance, errType)
+	}
+	P.labelTTLs.setTTL(protocolHTTPCollection, P.nsInstance)
+	for class, val := range map[string]string{
+		`4xx`: ss.Total4xxResponses,
+		`5xx`: ss.Total5xxResponses,
+	} {
+		protocolHTTPResponseClasses.WithLabelValues(P.nsInstance, class).Set(cast.ToFloat64(val))
+		P.labelTTLs.setTTL(protocolHTTPResponseClassCollection, P.nsInstance, class)
+	}
+}
+
+func (P *Pool) promProtocolIPStats(ss ProtocolIPStats) {
+	protocolIPRxPkts.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRxPkts))
+	protocolIPRxBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalRxBytes))
+	protocolIPTxPkts.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalTxPkts))
+	protocolIPTxBytes.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalTxBytes))
+	protocolIPFragments.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalFragments))
+	protocolIPReassemblyAttempts.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalReassemblyAttempts))
+	protocolIPSuccessReassembly.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSuccessReassembly))
+
+	for errType, val := range map[string]string{
+		`bad_checksum`:     ss.ErrBadChecksums,
+		`bad_length`:       ss.ErrBadLengths,
+		`bad_mac_address`:  ss.ErrBadMacAddresses,
+		`truncated_packet`: ss.ErrTruncatedPackets,
+		`unknown_service`:  ss.ErrUnknownServices,
+		`land_attack`:      ss.ErrLandAttacks,
+		`zero_source_ip`:   ss.ErrZeroSourceIP,
+	} {
+		protocolIPErrors.WithLabelValues(P.nsInstance, errType).Set(cast.ToFloat64(val))
+		P.labelTTLs.setTTL(protocolIPErrorCollection, P.nsInstance, errType)
+	}
+	P.labelTTLs.setTTL(protocolIPCollection, P.nsInstance)
+}
+
+var protocolTCPCollection = gaugeCollection{
+	protocolTCPRxPkts,
+	protocolTCPRxBytes,
+	protocolTCPTxPkts,
+	protocolTCPTxBytes,
+	protocolTCPSyn,
+	protocolTCPSynHeld,
+	protocolTCPSynProbes,
+	protocolTCPSynFlushed,
+	protocolTCPSynDropped,
+}
+
+var protocolTCPErrorCollection = gaugeCollection{
+	protocolTCPErrors,
+}
+
+var protocolHTTPCollection = gaugeCollection{
+	protocolHTTPRequests,
+	protocolHTTPResponses,
+	protocolHTTPRxRequestBytes,
+	protocolHTTPRxResponseBytes,
+	protocolHTTPGets,
+	protocolHTTPPosts,
+	protocolHTTPOthers,
+}
+
+var protocolHTTPErrorCollection = gaugeCollection{
+	protocolHTTPErrors,
+}
+
+var protocolHTTPResponseClassCollection = gaugeCollection{
+	protocolHTTPResponseClasses,
+}
+
+var protocolIPCollection = gaugeCollection{
+	protocolIPRxPkts,
+	protocolIPRxBytes,
+	protocolIPTxPkts,
+	protocolIPTxBytes,
+	protocolIPFragments,
+	protocolIPReassemblyAttempts,
+	protocolIPSuccessReassembly,
+}
+
+var protocolIPErrorCollection = gaugeCollection{
+	protocolIPErrors,
+}
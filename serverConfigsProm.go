@@ -0,0 +1,35 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/basic/server/
+
+const serverSubsystem = `server`
+
+var (
+	serverLabels = []string{netscalerInstance, `citrixadc_server_name`, `citrixadc_server_ip`, `citrixadc_server_domain`}
+	serverState  = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: serverSubsystem,
+			Name:      "state",
+			Help:      "Configured state of the server. 0 = DISABLED, 1 = ENABLED",
+		},
+		serverLabels,
+	)
+)
+
+func (P *Pool) promServerConfigs(ss ServerConfigs) {
+	var state float64
+	if ss.State == `ENABLED` {
+		state = 1
+	}
+	serverState.WithLabelValues(P.nsInstance, ss.Name, ss.IPAddress, ss.Domain).Set(state)
+	P.labelTTLs.setTTL(serverCollection, P.nsInstance, ss.Name, ss.IPAddress, ss.Domain)
+}
+
+var serverCollection = gaugeCollection{
+	serverState,
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/jbvmio/netscaler"
+	"go.uber.org/zap"
+)
+
+// ServiceGroupStats represents the data returned from the /stat/servicegroup Nitro API endpoint
+type ServiceGroupStats struct {
+	Name        string                    `json:"servicegroupname"`
+	State       CurState                  `json:"state"`
+	ServiceType string                    `json:"servicetype"`
+	Members     []ServiceGroupMemberStats `json:"servicegroupmember"`
+	lbvservers  []string
+}
+
+// ServiceGroupMemberStats represents the data returned from the /stat/servicegroupmember Nitro API endpoint
+type ServiceGroupMemberStats struct {
+	IPAddress                    string      `json:"primaryipaddress"`
+	Port                         NitroNumber `json:"primaryport"`
+	State                        CurState    `json:"state"`
+	AvgTimeToFirstByte           string      `json:"avgsvrttfb"`
+	TotalRequests                string      `json:"totalrequests"`
+	TotalResponses               string      `json:"totalresponses"`
+	TotalRequestBytes            string      `json:"totalrequestbytes"`
+	TotalResponseBytes           string      `json:"totalresponsebytes"`
+	CurrentClientConnections     string      `json:"curclntconnections"`
+	CurrentServerConnections     string      `json:"cursrvrconnections"`
+	ServerEstablishedConnections string      `json:"svrestablishedconn"`
+	SurgeCount                   string      `json:"surgecount"`
+}
+
+// NitroType implements the NitroData interface.
+func (s ServiceGroupStats) NitroType() string {
+	return servicegroupSubsystem
+}
+
+func processServiceGroupStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := servicegroupSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			serviceGroups, err := GetServiceGroupMemberStats(P)
+			switch {
+			case err != nil:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				P.insertBackoff(thisSS)
+			default:
+				P.logger.Debug("processing servicegroup stats", zap.String("subSystem", thisSS), zap.Int("number of servicegroups", len(serviceGroups)))
+				for _, sg := range serviceGroups {
+					req := newNitroDataReq(sg)
+					success := P.submit(req)
+					if !success {
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+				timeEnd := time.Now().UnixNano()
+				exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+// GetServiceGroupMemberStats retrieves stats for both ServiceGroups and their members along with the lbvservers they are bound to.
+func GetServiceGroupMemberStats(P *Pool) ([]ServiceGroupStats, error) {
+	var serviceGroups []ServiceGroupStats
+	bindings, err := GetSvcGroupBindings(P)
+	if err != nil {
+		exporterAPICollectFailures.WithLabelValues(P.nsInstance, servicegroupSubsystem).Inc()
+		return serviceGroups, err
+	}
+	bindMap := make(map[string][]string)
+	for _, b := range bindings {
+		bindMap[b.ServiceGroupName] = append(bindMap[b.ServiceGroupName], b.Name)
+	}
+	groups, err := getServiceGroupStats(P.client)
+	if err != nil {
+		exporterAPICollectFailures.WithLabelValues(P.nsInstance, servicegroupSubsystem).Inc()
+		return serviceGroups, err
+	}
+	for _, grp := range groups {
+		var retries int
+		s, err := getServiceGroupStats(P.client, grp.Name)
+	retryLoop:
+		for err != nil {
+			exporterAPICollectFailures.WithLabelValues(P.nsInstance, servicegroupMemberSubsystem).Inc()
+			if retries >= 3 {
+				break retryLoop
+			}
+			time.Sleep(time.Millisecond * 100)
+			s, err = getServiceGroupStats(P.client, grp.Name)
+			retries++
+		}
+		switch {
+		case err == nil:
+			for i := range s {
+				s[i].lbvservers = bindMap[s[i].Name]
+			}
+			serviceGroups = append(serviceGroups, s...)
+		default:
+			exporterMissedMetrics.WithLabelValues(P.nsInstance, servicegroupMemberSubsystem).Inc()
+		}
+	}
+	return serviceGroups, nil
+}
+
+func getServiceGroupStats(client *netscaler.NitroClient, target ...string) ([]ServiceGroupStats, error) {
+	var serviceGroups []ServiceGroupStats
+	var b []byte
+	var err error
+	switch len(target) {
+	case 0:
+		b, err = client.GetAll(netscaler.StatsTypeServiceGroupMember)
+	default:
+		grp := target[0]
+		b, err = client.Get(netscaler.StatsTypeServiceGroupMember, grp+`?statbindings=yes`)
+	}
+	if err != nil {
+		return serviceGroups, err
+	}
+	tmp := struct {
+		Target *[]ServiceGroupStats `json:"servicegroup"`
+	}{Target: &serviceGroups}
+	err = json.Unmarshal(b, &tmp)
+	if err != nil {
+		return serviceGroups, err
+	}
+	return serviceGroups, nil
+}
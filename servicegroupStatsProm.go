@@ -0,0 +1,188 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/basic/servicegroup/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/basic/servicegroupmember/
+
+const (
+	servicegroupSubsystem       = `servicegroup`
+	servicegroupMemberSubsystem = `servicegroup_member`
+)
+
+var (
+	servicegroupLabels = []string{netscalerInstance, `citrixadc_servicegroup_name`, `citrixadc_service_type`}
+	servicegroupState  = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupSubsystem,
+			Name:      "state",
+			Help:      "Current state of the service group. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		servicegroupLabels,
+	)
+)
+
+var (
+	servicegroupBindingLabels = []string{netscalerInstance, `citrixadc_servicegroup_name`, `citrixadc_lb_name`}
+	servicegroupBindingInfo   = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupSubsystem,
+			Name:      "binding_info",
+			Help:      "A metric with a constant '1' value linking a service group to a lbvserver it is bound to.",
+		},
+		servicegroupBindingLabels,
+	)
+)
+
+var (
+	servicegroupMemberLabels = []string{netscalerInstance, `citrixadc_servicegroup_name`, `citrixadc_member_ip`, `citrixadc_member_port`}
+	servicegroupMemberState  = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "state",
+			Help:      "Current state of the service group member. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberAvgTTFB = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "average_time_to_first_byte_seconds",
+			Help:      "Average TTFB between the NetScaler appliance and the server. TTFB is the time interval between sending the request packet to a service and receiving the first response from the service",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberTotalRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests received on this service group member",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberTotalResponses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of responses received on this service group member",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberTotalRequestBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "request_bytes_total",
+			Help:      "Total number of request bytes received on this service group member",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberTotalResponseBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total number of response bytes received on this service group member",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberCurrentClientConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "current_client_connections",
+			Help:      "Number of current client connections",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberCurrentServerConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "current_server_connections",
+			Help:      "Number of current connections to the actual servers",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberServerEstablishedConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "server_established_connections",
+			Help:      "Number of server connections in ESTABLISHED state",
+		},
+		servicegroupMemberLabels,
+	)
+
+	servicegroupMemberSurgeCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: servicegroupMemberSubsystem,
+			Name:      "surge_queue",
+			Help:      "Number of requests in the surge queue",
+		},
+		servicegroupMemberLabels,
+	)
+)
+
+func (P *Pool) promServiceGroupStats(ss ServiceGroupStats) {
+	servicegroupState.WithLabelValues(P.nsInstance, ss.Name, ss.ServiceType).Set(ss.State.Value())
+	P.labelTTLs.setTTL(servicegroupStatCollection, P.nsInstance, ss.Name, ss.ServiceType)
+	for _, lbName := range ss.lbvservers {
+		servicegroupBindingInfo.WithLabelValues(P.nsInstance, ss.Name, lbName).Set(1)
+		P.labelTTLs.setTTL(servicegroupBindingCollection, P.nsInstance, ss.Name, lbName)
+	}
+	for _, m := range ss.Members {
+		port := string(m.Port)
+		servicegroupMemberState.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(m.State.Value())
+		// Value is in milliseconds. Convert to base unit of seconds.
+		servicegroupMemberAvgTTFB.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.AvgTimeToFirstByte) * 0.001)
+		servicegroupMemberTotalRequests.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.TotalRequests))
+		servicegroupMemberTotalResponses.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.TotalResponses))
+		servicegroupMemberTotalRequestBytes.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.TotalRequestBytes))
+		servicegroupMemberTotalResponseBytes.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.TotalResponseBytes))
+		servicegroupMemberCurrentClientConns.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.CurrentClientConnections))
+		servicegroupMemberCurrentServerConns.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.CurrentServerConnections))
+		servicegroupMemberServerEstablishedConnections.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.ServerEstablishedConnections))
+		servicegroupMemberSurgeCount.WithLabelValues(P.nsInstance, ss.Name, m.IPAddress, port).Set(cast.ToFloat64(m.SurgeCount))
+		P.labelTTLs.setTTL(servicegroupMemberCollection, P.nsInstance, ss.Name, m.IPAddress, port)
+	}
+}
+
+var servicegroupStatCollection = gaugeCollection{
+	servicegroupState,
+}
+
+var servicegroupBindingCollection = gaugeCollection{
+	servicegroupBindingInfo,
+}
+
+var servicegroupMemberCollection = gaugeCollection{
+	servicegroupMemberState,
+	servicegroupMemberAvgTTFB,
+	servicegroupMemberTotalRequests,
+	servicegroupMemberTotalResponses,
+	servicegroupMemberTotalRequestBytes,
+	servicegroupMemberTotalResponseBytes,
+	servicegroupMemberCurrentClientConns,
+	servicegroupMemberCurrentServerConns,
+	servicegroupMemberServerEstablishedConnections,
+	servicegroupMemberSurgeCount,
+}
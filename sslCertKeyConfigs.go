@@ -0,0 +1,122 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawSSLCertKeyConfigs is the payload as returned by the Nitro API.
+type RawSSLCertKeyConfigs []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawSSLCertKeyConfigs) Len() int {
+	return len(r)
+}
+
+// RawSSLCertKeyBindings is the payload as returned by the Nitro API.
+type RawSSLCertKeyBindings []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawSSLCertKeyBindings) Len() int {
+	return len(r)
+}
+
+// SSLCertKeyConfigs represents the data returned from the /config/sslcertkey Nitro API endpoint
+type SSLCertKeyConfigs struct {
+	CertKey          string        `json:"certkey"`
+	Subject          string        `json:"subject"`
+	Issuer           string        `json:"issuer"`
+	DaysToExpiration NitroNumber   `json:"daystoexpiration"`
+	Status           SSLCertStatus `json:"status"`
+}
+
+// SSLCertKeyBindings represents the data returned from the /config/sslcertkey_sslvserver_binding Nitro API endpoint
+type SSLCertKeyBindings struct {
+	CertKey    string `json:"certkey"`
+	ServerName string `json:"servername"`
+}
+
+// SSLCertStatus is the status of a certificate as returned by the Nitro API.
+type SSLCertStatus string
+
+// Value returns the value mapping for the SSLCertStatus.
+func (s SSLCertStatus) Value() float64 {
+	switch s {
+	case `Expired`:
+		return 0.0
+	case `Valid`:
+		return 1.0
+	case `Not yet valid`:
+		return 2.0
+	default:
+		return 3.0
+	}
+}
+
+// NitroType implements the NitroData interface.
+func (s SSLCertKeyConfigs) NitroType() string {
+	return sslCertKeySubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s SSLCertKeyBindings) NitroType() string {
+	return sslCertKeySubsystem
+}
+
+func processSSLCertKeyConfigs(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := sslCertKeySubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			certData := submitAPITask(P, P.nitroConfig(`sslcertkey`))
+			bindData := submitAPITask(P, P.nitroConfig(`sslcertkey_sslvserver_binding?bulkbindings=yes`))
+			switch {
+			case len(certData) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				// Certificate expiry is still exported when only the vserver bindings could not be retrieved.
+				raws := []NitroRaw{RawSSLCertKeyConfigs(certData)}
+				switch {
+				case len(bindData) < 1:
+					P.logger.Error("error retrieving sslcertkey bindings for subSystem stat collection", zap.String("subSystem", thisSS))
+					exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				default:
+					raws = append(raws, RawSSLCertKeyBindings(bindData))
+				}
+				noErr := true
+				for _, raw := range raws {
+					req := newNitroRawReq(raw)
+					P.submit(req)
+					s := <-req.ResultChan()
+					if success, ok := s.(bool); !ok || !success {
+						noErr = false
+					}
+				}
+				switch {
+				case noErr:
+					go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+					timeEnd := time.Now().UnixNano()
+					exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+				default:
+					exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
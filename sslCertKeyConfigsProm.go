@@ -0,0 +1,67 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/ssl/sslcertkey/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/configuration/ssl/sslcertkey_sslvserver_binding/
+
+const sslCertKeySubsystem = `sslcertkey`
+
+var (
+	sslCertKeyLabels       = []string{netscalerInstance, `citrixadc_cert_name`, `citrixadc_cert_subject`, `citrixadc_cert_issuer`}
+	sslCertKeyDaysToExpire = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslCertKeySubsystem,
+			Name:      "days_to_expire",
+			Help:      "Number of days remaining for the certificate to expire",
+		},
+		sslCertKeyLabels,
+	)
+
+	sslCertKeyStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslCertKeySubsystem,
+			Name:      "status",
+			Help:      "Status of the certificate. 0 = EXPIRED, 1 = VALID, 2 = NOT YET VALID, 3 = UNKNOWN",
+		},
+		sslCertKeyLabels,
+	)
+)
+
+var (
+	sslCertKeyBindingLabels = []string{netscalerInstance, `citrixadc_cert_name`, `citrixadc_ssl_vserver_name`}
+	sslCertKeyBindingInfo   = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslCertKeySubsystem,
+			Name:      "binding_info",
+			Help:      "A metric with a constant '1' value linking a certificate to a SSL virtual server it is bound to.",
+		},
+		sslCertKeyBindingLabels,
+	)
+)
+
+func (P *Pool) promSSLCertKeyConfigs(N NitroData) {
+	switch ss := N.(type) {
+	case SSLCertKeyConfigs:
+		sslCertKeyDaysToExpire.WithLabelValues(P.nsInstance, ss.CertKey, ss.Subject, ss.Issuer).Set(ss.DaysToExpiration.Value())
+		sslCertKeyStatus.WithLabelValues(P.nsInstance, ss.CertKey, ss.Subject, ss.Issuer).Set(ss.Status.Value())
+		P.labelTTLs.setTTL(sslCertKeyCollection, P.nsInstance, ss.CertKey, ss.Subject, ss.Issuer)
+	case SSLCertKeyBindings:
+		sslCertKeyBindingInfo.WithLabelValues(P.nsInstance, ss.CertKey, ss.ServerName).Set(1)
+		P.labelTTLs.setTTL(sslCertKeyBindingCollection, P.nsInstance, ss.CertKey, ss.ServerName)
+	}
+}
+
+var sslCertKeyCollection = gaugeCollection{
+	sslCertKeyDaysToExpire,
+	sslCertKeyStatus,
+}
+
+var sslCertKeyBindingCollection = gaugeCollection{
+	sslCertKeyBindingInfo,
+}
@@ -23,11 +23,40 @@ type SSLStats struct {
 	SSLSessions          string `json:"sslcursessions"`
 }
 
+// RawSSLVServerStats is the payload as returned by the Nitro API.
+type RawSSLVServerStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawSSLVServerStats) Len() int {
+	return len(r)
+}
+
+// SSLVServerStats represents the data returned from the /stat/sslvserver Nitro API endpoint
+type SSLVServerStats struct {
+	Name                    string   `json:"vservername"`
+	State                   CurState `json:"state"`
+	Type                    string   `json:"type"`
+	TotalNewSessions        string   `json:"sslctxtotsessionnew"`
+	TotalSessionHits        string   `json:"sslctxtotsessionhits"`
+	TotalSessionMisses      string   `json:"sslctxtotsessionmiss"`
+	TotalRenegotiations     string   `json:"sslctxtotrenegsessions"`
+	TotalSSLv3Transactions  string   `json:"sslctxtotsslv3transactions"`
+	TotalTLSv1Transactions  string   `json:"sslctxtottlsv1transactions"`
+	TotalTLSv11Transactions string   `json:"sslctxtottlsv11transactions"`
+	TotalTLSv12Transactions string   `json:"sslctxtottlsv12transactions"`
+	TotalTLSv13Transactions string   `json:"sslctxtottlsv13transactions"`
+}
+
 // NitroType implements the NitroData interface.
 func (s SSLStats) NitroType() string {
 	return sslSubsystem
 }
 
+// NitroType implements the NitroData interface.
+func (s SSLVServerStats) NitroType() string {
+	return sslVServerSubsystem
+}
+
 func processSSLStats(P *Pool, wg *sync.WaitGroup) {
 	if wg != nil {
 		defer wg.Done()
@@ -69,3 +98,45 @@ func processSSLStats(P *Pool, wg *sync.WaitGroup) {
 		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
 	}
 }
+
+func processSSLVServerStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := sslVServerSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`sslvserver`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawSSLVServerStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
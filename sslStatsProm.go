@@ -5,7 +5,10 @@ import (
 	"github.com/spf13/cast"
 )
 
-const sslSubsystem = `ssl`
+const (
+	sslSubsystem        = `ssl`
+	sslVServerSubsystem = `ssl_vserver`
+)
 
 var (
 	sslLabels            = []string{netscalerInstance}
@@ -38,6 +41,82 @@ var (
 	)
 )
 
+var (
+	sslVServerLabels = []string{netscalerInstance, `citrixadc_ssl_vserver_name`, `citrixadc_ssl_vserver_type`}
+	sslVServerState  = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslVServerSubsystem,
+			Name:      "state",
+			Help:      "Current state of the server. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		sslVServerLabels,
+	)
+
+	sslVServerTotalHandshakes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslVServerSubsystem,
+			Name:      "handshakes_total",
+			Help:      "Number of new SSL sessions created through a full handshake on the virtual server",
+		},
+		sslVServerLabels,
+	)
+
+	sslVServerTotalRenegotiations = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslVServerSubsystem,
+			Name:      "renegotiations_total",
+			Help:      "Number of SSL session renegotiations on the virtual server",
+		},
+		sslVServerLabels,
+	)
+
+	sslVServerTotalSessionHits = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslVServerSubsystem,
+			Name:      "session_hits_total",
+			Help:      "Number of SSL session reuse hits on the virtual server",
+		},
+		sslVServerLabels,
+	)
+
+	sslVServerTotalSessionMisses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslVServerSubsystem,
+			Name:      "session_misses_total",
+			Help:      "Number of SSL session reuse misses on the virtual server",
+		},
+		sslVServerLabels,
+	)
+
+	sslVServerSessionReusePct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslVServerSubsystem,
+			Name:      "session_reuse_pct",
+			Help:      "Percentage of SSL session reuse attempts that were hits on the virtual server",
+		},
+		sslVServerLabels,
+	)
+)
+
+var (
+	sslVServerProtocolLabels    = []string{netscalerInstance, `citrixadc_ssl_vserver_name`, `citrixadc_ssl_vserver_type`, `citrixadc_ssl_protocol`}
+	sslVServerTotalTransactions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: sslVServerSubsystem,
+			Name:      "transactions_total",
+			Help:      "Number of SSL transactions on the virtual server by protocol version",
+		},
+		sslVServerProtocolLabels,
+	)
+)
+
 func (P *Pool) promSSLStats(ss SSLStats) {
 	sslTotalTransactions.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSSLTransactions))
 	sslTotalSessions.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalSSLSessions))
@@ -45,8 +124,48 @@ func (P *Pool) promSSLStats(ss SSLStats) {
 	P.labelTTLs.setTTL(sslStatCollection, P.nsInstance)
 }
 
+func (P *Pool) promSSLVServerStats(ss SSLVServerStats) {
+	hits := cast.ToFloat64(ss.TotalSessionHits)
+	misses := cast.ToFloat64(ss.TotalSessionMisses)
+	var reusePct float64
+	if hits+misses > 0 {
+		reusePct = hits / (hits + misses) * 100
+	}
+	sslVServerState.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(ss.State.Value())
+	sslVServerTotalHandshakes.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalNewSessions))
+	sslVServerTotalRenegotiations.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(cast.ToFloat64(ss.TotalRenegotiations))
+	sslVServerTotalSessionHits.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(hits)
+	sslVServerTotalSessionMisses.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(misses)
+	sslVServerSessionReusePct.WithLabelValues(P.nsInstance, ss.Name, ss.Type).Set(reusePct)
+	P.labelTTLs.setTTL(sslVServerStatCollection, P.nsInstance, ss.Name, ss.Type)
+	protocols := map[string]string{
+		`SSLv3`:   ss.TotalSSLv3Transactions,
+		`TLSv1`:   ss.TotalTLSv1Transactions,
+		`TLSv1.1`: ss.TotalTLSv11Transactions,
+		`TLSv1.2`: ss.TotalTLSv12Transactions,
+		`TLSv1.3`: ss.TotalTLSv13Transactions,
+	}
+	for protocol, val := range protocols {
+		sslVServerTotalTransactions.WithLabelValues(P.nsInstance, ss.Name, ss.Type, protocol).Set(cast.ToFloat64(val))
+		P.labelTTLs.setTTL(sslVServerProtocolCollection, P.nsInstance, ss.Name, ss.Type, protocol)
+	}
+}
+
 var sslStatCollection = gaugeCollection{
 	sslTotalTransactions,
 	sslTotalSessions,
 	sslCurrentSessions,
 }
+
+var sslVServerStatCollection = gaugeCollection{
+	sslVServerState,
+	sslVServerTotalHandshakes,
+	sslVServerTotalRenegotiations,
+	sslVServerTotalSessionHits,
+	sslVServerTotalSessionMisses,
+	sslVServerSessionReusePct,
+}
+
+var sslVServerProtocolCollection = gaugeCollection{
+	sslVServerTotalTransactions,
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RawVLANStats is the payload as returned by the Nitro API.
+type RawVLANStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawVLANStats) Len() int {
+	return len(r)
+}
+
+// RawBridgeStats is the payload as returned by the Nitro API.
+type RawBridgeStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawBridgeStats) Len() int {
+	return len(r)
+}
+
+// VLANStats represents the data returned from the /stat/vlan Nitro API endpoint
+type VLANStats struct {
+	ID                 NitroNumber `json:"id"`
+	TotalRxPkts        string      `json:"vlantotrxpkts"`
+	TotalRxBytes       string      `json:"vlantotrxbytes"`
+	TotalTxPkts        string      `json:"vlantottxpkts"`
+	TotalTxBytes       string      `json:"vlantottxbytes"`
+	TotalDroppedPkts   string      `json:"vlantotdroppedpkts"`
+	TotalBroadcastPkts string      `json:"vlantotbroadcastpkts"`
+}
+
+// BridgeStats represents the data returned from the /stat/bridge Nitro API endpoint
+type BridgeStats struct {
+	TotalMACMoved          string `json:"totmacmoved"`
+	TotalBridgeLoops       string `json:"totbridgeloops"`
+	TotalCollisions        string `json:"totcollisions"`
+	TotalInterfaceMismatch string `json:"totinterfacemismatch"`
+}
+
+// NitroType implements the NitroData interface.
+func (s VLANStats) NitroType() string {
+	return vlanSubsystem
+}
+
+// NitroType implements the NitroData interface.
+func (s BridgeStats) NitroType() string {
+	return bridgeSubsystem
+}
+
+func processVLANStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := vlanSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`vlan`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawVLANStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
+
+func processBridgeStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := bridgeSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, P.nitroStat(`bridge`))
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawBridgeStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/network/vlan/
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/network/bridge/
+
+const (
+	vlanSubsystem   = `vlan`
+	bridgeSubsystem = `bridge`
+)
+
+var (
+	vlanLabels = []string{netscalerInstance, `citrixadc_vlan_id`}
+	vlanRxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vlanSubsystem,
+			Name:      "received_packets_total",
+			Help:      "Total number of packets received on this VLAN",
+		},
+		vlanLabels,
+	)
+
+	vlanRxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vlanSubsystem,
+			Name:      "received_bytes_total",
+			Help:      "Total number of bytes received on this VLAN",
+		},
+		vlanLabels,
+	)
+
+	vlanTxPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vlanSubsystem,
+			Name:      "transmitted_packets_total",
+			Help:      "Total number of packets transmitted on this VLAN",
+		},
+		vlanLabels,
+	)
+
+	vlanTxBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vlanSubsystem,
+			Name:      "transmitted_bytes_total",
+			Help:      "Total number of bytes transmitted on this VLAN",
+		},
+		vlanLabels,
+	)
+
+	vlanDroppedPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vlanSubsystem,
+			Name:      "dropped_packets_total",
+			Help:      "Total number of packets dropped on this VLAN",
+		},
+		vlanLabels,
+	)
+
+	vlanBroadcastPkts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vlanSubsystem,
+			Name:      "broadcast_packets_total",
+			Help:      "Total number of broadcast packets received on this VLAN",
+		},
+		vlanLabels,
+	)
+)
+
+var (
+	bridgeLabels   = []string{netscalerInstance}
+	bridgeMACMoved = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: bridgeSubsystem,
+			Name:      "mac_moved_total",
+			Help:      "Total number of MAC moves between ports",
+		},
+		bridgeLabels,
+	)
+
+	bridgeLoops = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: bridgeSubsystem,
+			Name:      "loops_total",
+			Help:      "Total number of bridging loops detected",
+		},
+		bridgeLabels,
+	)
+
+	bridgeCollisions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: bridgeSubsystem,
+			Name:      "collisions_total",
+			Help:      "Total number of collisions between the IP address of the appliance and other devices",
+		},
+		bridgeLabels,
+	)
+
+	bridgeInterfaceMismatch = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: bridgeSubsystem,
+			Name:      "interface_mismatch_total",
+			Help:      "Total number of packets dropped because of an interface mismatch",
+		},
+		bridgeLabels,
+	)
+)
+
+func (P *Pool) promVLANStats(ss VLANStats) {
+	id := string(ss.ID)
+	vlanRxPkts.WithLabelValues(P.nsInstance, id).Set(cast.ToFloat64(ss.TotalRxPkts))
+	vlanRxBytes.WithLabelValues(P.nsInstance, id).Set(cast.ToFloat64(ss.TotalRxBytes))
+	vlanTxPkts.WithLabelValues(P.nsInstance, id).Set(cast.ToFloat64(ss.TotalTxPkts))
+	vlanTxBytes.WithLabelValues(P.nsInstance, id).Set(cast.ToFloat64(ss.TotalTxBytes))
+	vlanDroppedPkts.WithLabelValues(P.nsInstance, id).Set(cast.ToFloat64(ss.TotalDroppedPkts))
+	vlanBroadcastPkts.WithLabelValues(P.nsInstance, id).Set(cast.ToFloat64(ss.TotalBroadcastPkts))
+	P.labelTTLs.setTTL(vlanCollection, P.nsInstance, id)
+}
+
+func (P *Pool) promBridgeStats(ss BridgeStats) {
+	bridgeMACMoved.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalMACMoved))
+	bridgeLoops.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalBridgeLoops))
+	bridgeCollisions.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalCollisions))
+	bridgeInterfaceMismatch.WithLabelValues(P.nsInstance).Set(cast.ToFloat64(ss.TotalInterfaceMismatch))
+	P.labelTTLs.setTTL(bridgeCollection, P.nsInstance)
+}
+
+var vlanCollection = gaugeCollection{
+	vlanRxPkts,
+	vlanRxBytes,
+	vlanTxPkts,
+	vlanTxBytes,
+	vlanDroppedPkts,
+	vlanBroadcastPkts,
+}
+
+var bridgeCollection = gaugeCollection{
+	bridgeMACMoved,
+	bridgeLoops,
+	bridgeCollisions,
+	bridgeInterfaceMismatch,
+}
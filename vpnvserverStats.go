@@ -0,0 +1,75 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jbvmio/netscaler"
+	"go.uber.org/zap"
+)
+
+// RawVPNVServerStats is the payload as returned by the Nitro API.
+type RawVPNVServerStats []byte
+
+// Len returns the size of the underlying []byte.
+func (r RawVPNVServerStats) Len() int {
+	return len(r)
+}
+
+// VPNVServerStats represents the data returned from the /stat/vpnvserver Nitro API endpoint
+type VPNVServerStats struct {
+	Name               string   `json:"name"`
+	State              CurState `json:"state"`
+	CurrentUsers       string   `json:"curtotalusers"`
+	TotalRequests      string   `json:"totalrequests"`
+	TotalResponses     string   `json:"totalresponses"`
+	TotalRequestBytes  string   `json:"totalrequestbytes"`
+	TotalResponseBytes string   `json:"totalresponsebytes"`
+}
+
+// NitroType implements the NitroData interface.
+func (s VPNVServerStats) NitroType() string {
+	return vpnSubsystem
+}
+
+func processVPNVServerStats(P *Pool, wg *sync.WaitGroup) {
+	if wg != nil {
+		defer wg.Done()
+	}
+	thisSS := vpnSubsystem
+	switch {
+	case P.metricFlipBit[thisSS].good():
+		defer P.metricFlipBit[thisSS].flip()
+		timeBegin := time.Now().UnixNano()
+		switch {
+		case P.stopped:
+			P.logger.Info("Skipping sybSystem stat collection, process is stopping", zap.String("subSystem", thisSS))
+		default:
+			P.logger.Debug("Processing subSystem Stats", zap.String("subSystem", thisSS))
+			data := submitAPITask(P, netscaler.StatsTypeVPNVServer)
+			switch {
+			case len(data) < 1:
+				P.logger.Error("error retrieving data for subSystem stat collection", zap.String("subSystem", thisSS))
+				exporterAPICollectFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+				P.insertBackoff(thisSS)
+			default:
+				req := newNitroRawReq(RawVPNVServerStats(data))
+				P.submit(req)
+				s := <-req.ResultChan()
+				if success, ok := s.(bool); ok {
+					switch {
+					case success:
+						go TK.set(P.nsInstance, thisSS, float64(time.Now().UnixNano()))
+						timeEnd := time.Now().UnixNano()
+						exporterPromProcessingTime.WithLabelValues(P.nsInstance, thisSS).Set(float64((timeEnd - timeBegin) / nanoSecond))
+					default:
+						exporterProcessingFailures.WithLabelValues(P.nsInstance, thisSS).Inc()
+					}
+				}
+				P.logger.Debug("subSystem stat collection Complete", zap.String("subSystem", thisSS))
+			}
+		}
+	default:
+		P.logger.Debug("subSystem stat collection already in progress", zap.String("subSystem", thisSS))
+	}
+}
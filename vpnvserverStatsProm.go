@@ -0,0 +1,92 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+)
+
+// https://developer-docs.citrix.com/projects/netscaler-nitro-api/en/12.0/statistics/vpn/vpnvserver/
+
+const vpnSubsystem = `vpn`
+
+var (
+	vpnVServerLabels = []string{netscalerInstance, `citrixadc_vpn_name`}
+	vpnVServerState  = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "state",
+			Help:      "Current state of the server. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		vpnVServerLabels,
+	)
+
+	vpnVServerCurUsers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "current_users",
+			Help:      "Number of users currently logged on to this virtual server",
+		},
+		vpnVServerLabels,
+	)
+
+	vpnVServerTotalRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests received on this virtual server",
+		},
+		vpnVServerLabels,
+	)
+
+	vpnVServerTotalResponses = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of responses sent by this virtual server",
+		},
+		vpnVServerLabels,
+	)
+
+	vpnVServerTotalRequestBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "request_bytes_total",
+			Help:      "Total number of request bytes received on this virtual server",
+		},
+		vpnVServerLabels,
+	)
+
+	vpnVServerTotalResponseBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total number of response bytes sent by this virtual server",
+		},
+		vpnVServerLabels,
+	)
+)
+
+func (P *Pool) promVPNVServerStats(ss VPNVServerStats) {
+	vpnVServerState.WithLabelValues(P.nsInstance, ss.Name).Set(ss.State.Value())
+	vpnVServerCurUsers.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.CurrentUsers))
+	vpnVServerTotalRequests.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalRequests))
+	vpnVServerTotalResponses.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalResponses))
+	vpnVServerTotalRequestBytes.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalRequestBytes))
+	vpnVServerTotalResponseBytes.WithLabelValues(P.nsInstance, ss.Name).Set(cast.ToFloat64(ss.TotalResponseBytes))
+	P.labelTTLs.setTTL(vpnVServerCollection, P.nsInstance, ss.Name)
+}
+
+var vpnVServerCollection = gaugeCollection{
+	vpnVServerState,
+	vpnVServerCurUsers,
+	vpnVServerTotalRequests,
+	vpnVServerTotalResponses,
+	vpnVServerTotalRequestBytes,
+	vpnVServerTotalResponseBytes,
+}